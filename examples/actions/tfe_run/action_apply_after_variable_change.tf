@@ -0,0 +1,29 @@
+# Apply the Workspace After a Variable Changes
+
+resource "tfe_workspace" "example" {
+  name         = "example-workspace"
+  organization = "my-organization"
+}
+
+resource "tfe_variable" "example" {
+  key          = "my_key"
+  value        = "my_value"
+  category     = "terraform"
+  workspace_id = tfe_workspace.example.id
+
+  # Apply the workspace after the variable is created or updated
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.tfe_run.apply]
+    }
+  }
+}
+
+action "tfe_run" "apply" {
+  config {
+    workspace_id = tfe_workspace.example.id
+    operation    = "apply"
+    message      = "Applied after my_key changed"
+  }
+}
@@ -0,0 +1,9 @@
+# Plan a Specific Configuration Version
+
+action "tfe_run" "plan" {
+  config {
+    workspace_id             = "ws-CZcmD7eagjhyXavN"
+    operation                = "plan"
+    configuration_version_id = "cv-ntv3HbhJqvFzamy7"
+  }
+}
@@ -0,0 +1 @@
+terraform apply -invoke=action.tfe_run.plan
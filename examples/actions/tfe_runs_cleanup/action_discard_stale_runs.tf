@@ -0,0 +1,11 @@
+# Discard the Runs Queued by a Burst of VCS Events
+
+action "tfe_runs_cleanup" "vcs_storm" {
+  config {
+    organization = "my-organization"
+    project_id   = "prj-AHqYcX4kx7MZy3Hx"
+    operation    = "discard"
+    older_than   = "2h"
+    comment      = "Discarding the runs queued by a burst of VCS events"
+  }
+}
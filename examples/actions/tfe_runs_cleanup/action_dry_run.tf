@@ -0,0 +1,16 @@
+# Report the Runs That Would Be Canceled
+
+action "tfe_runs_cleanup" "report" {
+  config {
+    organization = "my-organization"
+    operation    = "cancel"
+    statuses     = ["planning", "applying"]
+    dry_run      = true
+
+    tag_filters = {
+      include = {
+        environment = "staging"
+      }
+    }
+  }
+}
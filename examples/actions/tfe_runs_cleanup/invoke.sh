@@ -0,0 +1 @@
+terraform apply -invoke=action.tfe_runs_cleanup.report
@@ -0,0 +1,12 @@
+# Lock Workspaces by ID
+
+data "tfe_workspace_ids" "network" {
+  names        = ["network-*"]
+  organization = "my-organization"
+}
+
+action "tfe_workspace_lock" "network" {
+  config {
+    workspace_ids = values(data.tfe_workspace_ids.network.ids)
+  }
+}
@@ -0,0 +1,17 @@
+# Lock Workspaces by Tag Before a Maintenance Window
+
+action "tfe_workspace_lock" "maintenance" {
+  config {
+    organization = "my-organization"
+    reason       = "Database maintenance window"
+
+    tag_filters = {
+      include = {
+        environment = "prod"
+      }
+      exclude = {
+        critical = "*"
+      }
+    }
+  }
+}
@@ -0,0 +1 @@
+terraform apply -invoke=action.tfe_workspace_lock.maintenance
@@ -0,0 +1,8 @@
+# Force Unlock a Workspace Left Locked by a Crashed Agent
+
+action "tfe_workspace_unlock" "stuck" {
+  config {
+    workspace_ids = ["ws-CZcmD7eagjhyXavN"]
+    force         = true
+  }
+}
@@ -0,0 +1,13 @@
+# Unlock Workspaces by Tag After a Maintenance Window
+
+action "tfe_workspace_unlock" "maintenance" {
+  config {
+    organization = "my-organization"
+
+    tag_filters = {
+      include = {
+        environment = "prod"
+      }
+    }
+  }
+}
@@ -0,0 +1,9 @@
+# Basic usage
+
+data "tfe_plan_json" "this" {
+  run_id = "run-CZcmD7eagjhyX0vN"
+}
+
+output "destroyed_addresses" {
+  value = data.tfe_plan_json.this.destroyed_addresses
+}
@@ -0,0 +1,30 @@
+# Refuse to promote to production when the latest staging plan destroys stateful resources
+
+data "tfe_workspace" "staging" {
+  name         = "staging"
+  organization = "my-org-name"
+}
+
+data "tfe_plan_json" "staging" {
+  workspace_id = data.tfe_workspace.staging.id
+}
+
+locals {
+  stateful_types = ["aws_db_instance", "aws_s3_bucket", "aws_dynamodb_table"]
+
+  destroyed_stateful_resources = [
+    for change in data.tfe_plan_json.staging.resource_changes : change.address
+    if contains(change.actions, "delete") && contains(local.stateful_types, change.type)
+  ]
+}
+
+resource "terraform_data" "promote" {
+  input = data.tfe_plan_json.staging.run_id
+
+  lifecycle {
+    precondition {
+      condition     = length(local.destroyed_stateful_resources) == 0
+      error_message = "The staging plan destroys stateful resources: ${join(", ", local.destroyed_stateful_resources)}."
+    }
+  }
+}
@@ -0,0 +1,9 @@
+# Basic usage
+
+data "tfe_run" "this" {
+  id = "run-CZcmD7eagjhyX0vN"
+}
+
+output "applied_at" {
+  value = data.tfe_run.this.status_timestamps["applied_at"]
+}
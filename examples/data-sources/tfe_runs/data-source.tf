@@ -0,0 +1,6 @@
+# Basic usage
+
+data "tfe_runs" "applied" {
+  workspace_id = "ws-6jrRyVDv1J8zQMB5"
+  statuses     = ["applied"]
+}
@@ -0,0 +1,23 @@
+# Assert that a production workspace had a successful apply in the last 30 days
+
+data "tfe_workspace" "production" {
+  name         = "production"
+  organization = "my-org-name"
+}
+
+resource "time_offset" "thirty_days_ago" {
+  offset_days = -30
+}
+
+data "tfe_runs" "recent_applies" {
+  workspace_id  = data.tfe_workspace.production.id
+  statuses      = ["applied"]
+  created_after = time_offset.thirty_days_ago.rfc3339
+}
+
+check "recent_apply" {
+  assert {
+    condition     = length(data.tfe_runs.recent_applies.runs) > 0
+    error_message = "The production workspace has not been applied in the last 30 days."
+  }
+}
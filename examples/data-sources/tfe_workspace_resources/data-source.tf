@@ -0,0 +1,7 @@
+# Basic usage
+
+data "tfe_workspace_resources" "buckets" {
+  workspace_id  = "ws-6jrRyVDv1J8zQMB5"
+  types         = ["aws_s3_bucket"]
+  module_prefix = "module.storage"
+}
@@ -0,0 +1,21 @@
+# Inventory of the S3 buckets managed by all the workspaces of an organization
+
+data "tfe_workspace_ids" "all" {
+  names        = ["*"]
+  organization = "my-org-name"
+}
+
+data "tfe_workspace_resources" "buckets" {
+  for_each = data.tfe_workspace_ids.all.ids
+
+  workspace_id = each.value
+  types        = ["aws_s3_bucket"]
+}
+
+output "s3_buckets" {
+  value = {
+    for name, workspace in data.tfe_workspace_resources.buckets :
+    name => workspace.resources[*].address
+    if length(workspace.resources) > 0
+  }
+}
@@ -0,0 +1,11 @@
+# Basic usage
+
+resource "tfe_workspace" "test" {
+  name         = "my-workspace-name"
+  organization = "my-org-name"
+}
+
+resource "tfe_state_version" "test" {
+  workspace_id = tfe_workspace.test.id
+  state_file   = "${path.module}/terraform.tfstate"
+}
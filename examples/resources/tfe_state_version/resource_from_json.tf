@@ -0,0 +1,7 @@
+# Forcing the upload of a state over a newer state version
+
+resource "tfe_state_version" "test" {
+  workspace_id = tfe_workspace.test.id
+  state_json   = file("${path.module}/migrated.tfstate")
+  force        = true
+}
@@ -17,9 +17,7 @@ import (
 	tfe "github.com/hashicorp/go-tfe"
 	tfev2 "github.com/hashicorp/go-tfe/v2"
 	"github.com/hashicorp/go-version"
-	"github.com/hashicorp/terraform-provider-tfe/internal/logging"
 	providerVersion "github.com/hashicorp/terraform-provider-tfe/version"
-	svchost "github.com/hashicorp/terraform-svchost"
 	"github.com/hashicorp/terraform-svchost/disco"
 )
 
@@ -30,12 +28,18 @@ const (
 var (
 	ErrMissingAuthToken = errors.New("required token could not be found. Please set the token using an input variable in the provider configuration block or by using the TFE_TOKEN environment variable")
 	tfeServiceIDs       = []string{"tfe.v2.2"}
+
+	// knownServiceIDs are the services reported by ProviderClient.Services
+	// when the host provides them.
+	knownServiceIDs = []string{"modules.v1", "motd.v1", "providers.v1", "state.v2", "tfe.v2", "tfe.v2.1", "tfe.v2.2"}
 )
 
 type ClientConfigMap struct {
 	mu       sync.Mutex
 	valuesV1 map[string]*tfe.Client
 	values   map[string]*tfev2.Client
+	lookups  map[string]*LookupCache
+	services map[string]map[string]string
 }
 
 func (c *ClientConfigMap) GetByConfig(config *ClientConfiguration) (*tfe.Client, *tfev2.Client) {
@@ -46,6 +50,26 @@ func (c *ClientConfigMap) GetByConfig(config *ClientConfiguration) (*tfe.Client,
 	return c.valuesV1[config.Key()], c.values[config.Key()]
 }
 
+// GetLookupsByConfig returns the lookup cache of the clients cached for the
+// given configuration.
+func (c *ClientConfigMap) GetLookupsByConfig(config *ClientConfiguration) *LookupCache {
+	if c.mu.TryLock() {
+		defer c.Unlock()
+	}
+
+	return c.lookups[config.Key()]
+}
+
+// GetServicesByConfig returns the services discovered when creating the
+// clients cached for the given configuration.
+func (c *ClientConfigMap) GetServicesByConfig(config *ClientConfiguration) map[string]string {
+	if c.mu.TryLock() {
+		defer c.Unlock()
+	}
+
+	return c.services[config.Key()]
+}
+
 func (c *ClientConfigMap) Lock() {
 	c.mu.Lock()
 }
@@ -60,6 +84,8 @@ func (c *ClientConfigMap) Set(client *tfe.Client, clientV2 *tfev2.Client, config
 	}
 	c.valuesV1[config.Key()] = client
 	c.values[config.Key()] = clientV2
+	c.lookups[config.Key()] = config.Lookups
+	c.services[config.Key()] = config.discoveredServices
 }
 
 func getTokenFromEnv() string {
@@ -67,23 +93,23 @@ func getTokenFromEnv() string {
 	return os.Getenv("TFE_TOKEN")
 }
 
-func getTokenFromCreds(services *disco.Disco, hostname svchost.Hostname) string {
-	log.Printf("[DEBUG] Attempting to fetch token from Terraform CLI configuration for configured hostname")
-	creds, err := services.CredentialsForHost(hostname)
-	if err != nil {
-		log.Printf("[DEBUG] Failed to get credentials for %s: %s (ignoring)", logging.Sanitize(string(hostname)), logging.Sanitize(err.Error())) // nolint:gosec
-	}
-	if creds != nil {
-		return creds.Token()
-	}
-	return ""
-}
-
 // TFE Client along with other necessary information for the provider to run it
 type ProviderClient struct {
 	TfeClient   *tfe.Client
 	TFEClientV2 *tfev2.Client
 	tokenSource tokenSource
+
+	// ReadOnly is true when the clients refuse every request that is not a
+	// GET, so that resources can report it at plan time.
+	ReadOnly bool
+
+	// Lookups caches the name to ID lookups made with the clients. It is nil
+	// when the lookup cache is disabled.
+	Lookups *LookupCache
+
+	// Services maps the IDs of the services discovered on the host, such as
+	// "tfe.v2.2" or "modules.v1", to their URLs.
+	Services map[string]string
 }
 
 // Using presence of TFC_AGENT_VERSION to determine if this provider is running on HCP Terraform / enterprise
@@ -92,8 +118,16 @@ func providerRunningInCloud() bool {
 	return envVariablePresent
 }
 
+// SendAuthenticationWarning returns true when the token was read from the
+// Terraform CLI credentials while running in HCP Terraform or Terraform
+// Enterprise, where those credentials have limited permissions.
 func (pc *ProviderClient) SendAuthenticationWarning() bool {
-	return pc.tokenSource == credentialFiles && providerRunningInCloud()
+	switch pc.tokenSource {
+	case credentialFiles, hostEnvironmentVariable, credentialsHelper:
+		return providerRunningInCloud()
+	default:
+		return false
+	}
 }
 
 // GetClient encapsulates the logic for configuring a go-tfe client instance for
@@ -103,8 +137,8 @@ func (pc *ProviderClient) SendAuthenticationWarning() bool {
 //
 // Internally, this function caches configured clients using the specified
 // parameters
-func GetClient(tfeHost, token string, insecure bool) (*ProviderClient, error) {
-	config, err := configure(tfeHost, token, insecure)
+func GetClient(tfeHost, token string, insecure bool, opts ClientOptions) (*ProviderClient, error) {
+	config, err := configure(tfeHost, token, insecure, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -115,7 +149,11 @@ func GetClient(tfeHost, token string, insecure bool) (*ProviderClient, error) {
 	// Try to retrieve the client from cache
 	cachedV1, cachedV2 := clientCache.GetByConfig(config)
 	if cachedV1 != nil && cachedV2 != nil {
-		return &ProviderClient{TfeClient: cachedV1, TFEClientV2: cachedV2, tokenSource: config.tokenSource}, nil
+		pc := &ProviderClient{TfeClient: cachedV1, TFEClientV2: cachedV2, tokenSource: config.tokenSource, ReadOnly: config.ReadOnly, Services: clientCache.GetServicesByConfig(config)}
+		if !config.SkipLookupCache {
+			pc.Lookups = clientCache.GetLookupsByConfig(config)
+		}
+		return pc, nil
 	}
 
 	// Discover the Terraform Enterprise address.
@@ -176,28 +214,40 @@ func GetClient(tfeHost, token string, insecure bool) (*ProviderClient, error) {
 		return nil, fmt.Errorf("failed to create client: %w", err)
 	}
 
+	// Retries are handled by the shared transport according to config.Retry,
+	// so the retry logic built into go-tfe v2 is disabled.
 	v2Client, err := tfev2.NewClient(&tfev2.Config{
 		Address:           address.String(),
 		Token:             config.Token,
-		RetryServerErrors: true,
-		RetryRateLimited:  true,
-		RetryMaxRetries:   10,
+		RetryServerErrors: false,
+		RetryRateLimited:  false,
 		Headers:           http.Header{"User-Agent": []string{TFEUserAgent}},
-		RetryHook: func(attempt int, resp *http.Response) {
-			if resp.StatusCode == http.StatusTooManyRequests {
-				log.Printf("[DEBUG] Rate limited by TFE API, retrying request (attempt %d)", attempt)
-			}
-		},
-		HTTPTransport: config.HTTPClient.Transport,
+		HTTPTransport:     config.HTTPClient.Transport,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create client: %w", err)
 	}
 
-	client.RetryServerErrors(true)
+	config.discoveredServices = discoveredServices(host)
 	clientCache.Set(client, v2Client, config)
 
-	return &ProviderClient{TfeClient: client, TFEClientV2: v2Client, tokenSource: config.tokenSource}, nil
+	pc := &ProviderClient{TfeClient: client, TFEClientV2: v2Client, tokenSource: config.tokenSource, ReadOnly: config.ReadOnly, Services: config.discoveredServices}
+	if !config.SkipLookupCache {
+		pc.Lookups = config.Lookups
+	}
+	return pc, nil
+}
+
+// discoveredServices returns the URLs of the known services provided by the
+// discovered host.
+func discoveredServices(host *disco.Host) map[string]string {
+	services := make(map[string]string)
+	for _, id := range knownServiceIDs {
+		if u, err := host.ServiceURL(id); err == nil && u != nil {
+			services[id] = u.String()
+		}
+	}
+	return services
 }
 
 // CheckConstraints checks service version constrains against our own
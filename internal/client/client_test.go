@@ -143,7 +143,7 @@ credentials "%s" {
 			t.Setenv(k, v)
 		}
 		// Must always skip SSL verification for this test server
-		providerClient, err := GetClient(c.hostname, c.token, true)
+		providerClient, err := GetClient(c.hostname, c.token, true, ClientOptions{})
 
 		if c.expectMissingAuth {
 			if !errors.Is(err, ErrMissingAuthToken) {
@@ -166,6 +166,13 @@ credentials "%s" {
 			t.Fatalf("Expected token source %d, got %d", c.expectTokenSource, tokenSource)
 		}
 
+		if got, want := providerClient.Services["tfe.v2.2"], srv.URL+"/api/v2/"; got != want {
+			t.Errorf("Expected discovered tfe.v2.2 service %q, got %q", want, got)
+		}
+		if _, ok := providerClient.Services["modules.v1"]; ok {
+			t.Errorf("Expected modules.v1 not to be discovered, got %v", providerClient.Services)
+		}
+
 		_, err = client.Organizations.List(context.Background(), &tfe.OrganizationListOptions{})
 		if err != nil {
 			t.Errorf("Unexpected error from using client: %q", err)
@@ -191,6 +198,16 @@ func TestClient_sendAuthenticationWarning(t *testing.T) {
 			tfcAgentVersionEnvVariableSet: false,
 			expectResult:                  false,
 		},
+		"token from TF_TOKEN env and TFC_AGENT_VERSION is set": {
+			tokenSource:                   hostEnvironmentVariable,
+			tfcAgentVersionEnvVariableSet: true,
+			expectResult:                  true,
+		},
+		"token from credentials helper and TFC_AGENT_VERSION is set": {
+			tokenSource:                   credentialsHelper,
+			tfcAgentVersionEnvVariableSet: true,
+			expectResult:                  true,
+		},
 		"TFC_AGENT_VERSION is set but token not from credentials files": {
 			tokenSource:                   providerArgument,
 			tfcAgentVersionEnvVariableSet: true,
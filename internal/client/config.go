@@ -17,7 +17,6 @@ import (
 	"github.com/hashicorp/terraform-provider-tfe/internal/logging"
 	providerVersion "github.com/hashicorp/terraform-provider-tfe/version"
 	svchost "github.com/hashicorp/terraform-svchost"
-	"github.com/hashicorp/terraform-svchost/auth"
 	"github.com/hashicorp/terraform-svchost/disco"
 )
 
@@ -30,8 +29,9 @@ type CredentialsMap map[string]map[string]interface{}
 
 // CLIHostConfig is the structure of the configuration for the Terraform CLI.
 type CLIHostConfig struct {
-	Hosts       map[string]*ConfigHost `hcl:"host"`
-	Credentials CredentialsMap         `hcl:"credentials"`
+	Hosts              map[string]*ConfigHost              `hcl:"host"`
+	Credentials        CredentialsMap                      `hcl:"credentials"`
+	CredentialsHelpers map[string]*ConfigCredentialsHelper `hcl:"credentials_helper"`
 }
 
 // ConfigHost is the structure of the "host" nested block within the CLI
@@ -47,8 +47,43 @@ const (
 	providerArgument tokenSource = iota
 	environmentVariable
 	credentialFiles
+	hostEnvironmentVariable
+	credentialsHelper
 )
 
+// ClientOptions are the optional provider-level settings that customize how
+// the clients returned by GetClient talk to HCP Terraform or Terraform Enterprise.
+type ClientOptions struct {
+	// Retry is the retry policy applied to API requests. When nil, the
+	// DefaultRetryConfig policy is used.
+	Retry *RetryConfig
+
+	// Throttle limits the rate and concurrency of API requests. Unset values
+	// fall back to the TFE_REQUESTS_PER_SECOND and TFE_MAX_CONCURRENT_REQUESTS
+	// environment variables.
+	Throttle ThrottleConfig
+
+	// TLS adds custom certificate authorities and a client certificate to the
+	// connections made to the configured host. Unset values fall back to the
+	// TFE_CA_CERT_FILE, TFE_CA_CERT_PEM, TFE_CLIENT_CERT_FILE,
+	// TFE_CLIENT_CERT_PEM, TFE_CLIENT_KEY_FILE and TFE_CLIENT_KEY_PEM
+	// environment variables.
+	TLS TLSConfig
+
+	// Proxy is the HTTP(S) proxy used for service discovery and API requests.
+	// Unset values fall back to the TFE_PROXY_URL, TFE_NO_PROXY,
+	// TFE_PROXY_USERNAME and TFE_PROXY_PASSWORD environment variables.
+	Proxy ProxyConfig
+
+	// ReadOnly refuses every API request that is not a GET. When false, it
+	// falls back to the TFE_READ_ONLY environment variable.
+	ReadOnly bool
+
+	// SkipLookupCache disables the cache of name to ID lookups. When false,
+	// it falls back to the TFE_SKIP_LOOKUP_CACHE environment variable.
+	SkipLookupCache bool
+}
+
 // ClientConfiguration is the refined information needed to configureClient a tfe.Client
 type ClientConfiguration struct {
 	Services    *disco.Disco
@@ -57,12 +92,25 @@ type ClientConfiguration struct {
 	Token       string
 	tokenSource tokenSource
 	Insecure    bool
+	Retry       RetryConfig
+	Throttle    ThrottleConfig
+	TLS         TLSConfig
+	Proxy       ProxyConfig
+	ReadOnly    bool
+
+	// Lookups is invalidated by the writes made through HTTPClient.
+	// SkipLookupCache does not change the clients, so it is not part of Key.
+	Lookups         *LookupCache
+	SkipLookupCache bool
+
+	// discoveredServices is set by GetClient once the host is discovered.
+	discoveredServices map[string]string
 }
 
 // Key returns a string that is comparable to other ClientConfiguration values
 func (c ClientConfiguration) Key() string {
 	h := sha256.Sum256([]byte(c.Token))
-	return fmt.Sprintf("%x %s/%v", h[:], c.TFEHost, c.Insecure)
+	return fmt.Sprintf("%x %s/%v/%s/%s/%s/%s/%v", h[:], c.TFEHost, c.Insecure, c.Retry, c.Throttle, c.TLS, c.Proxy, c.ReadOnly)
 }
 
 // cliConfig tries to find and parse the configuration of the Terraform CLI.
@@ -89,8 +137,10 @@ func cliConfig() CLIHostConfig {
 		credentialsConfig = readCliConfigFile(credentialsFilePath)
 	}
 
-	// Use host service discovery configs from main config file.
+	// Use host service discovery configs and the credentials helper from the
+	// main config file.
 	combinedConfig.Hosts = mainConfig.Hosts
+	combinedConfig.CredentialsHelpers = mainConfig.CredentialsHelpers
 
 	// Combine both sets of credentials. Per Terraform's own behavior, the main
 	// config file overrides the credentials file if they have any overlapping
@@ -153,30 +203,9 @@ func readCliConfigFile(configFilePath string) CLIHostConfig {
 	return config
 }
 
-func credentialsSource(credentials CredentialsMap) auth.CredentialsSource {
-	creds := auth.NoCredentials
-
-	// Add all configured credentials to the credentials source.
-	if len(credentials) > 0 {
-		staticTable := map[svchost.Hostname]map[string]interface{}{}
-		for userHost, creds := range credentials {
-			host, err := svchost.ForComparison(userHost)
-			if err != nil {
-				// We expect the config was already validated by the time we get
-				// here, so we'll just ignore invalid hostnames.
-				continue
-			}
-			staticTable[host] = creds
-		}
-		creds = auth.StaticCredentialsSource(staticTable)
-	}
-
-	return creds
-}
-
 // configure accepts the provider-level configuration values and creates a
 // clientConfiguration using fallback values from the environment or CLI configuration.
-func configure(tfeHost, token string, insecure bool) (*ClientConfiguration, error) {
+func configure(tfeHost, token string, insecure bool, opts ClientOptions) (*ClientConfiguration, error) {
 	if tfeHost == "" {
 		if os.Getenv("TFE_HOSTNAME") != "" {
 			tfeHost = os.Getenv("TFE_HOSTNAME")
@@ -204,6 +233,52 @@ func configure(tfeHost, token string, insecure bool) (*ClientConfiguration, erro
 		log.Printf("[DEBUG] Warning: Client configured to skip certificate verifications")
 	}
 
+	// Like ssl_skip_verify, the env var can only turn read-only mode on.
+	readOnly := opts.ReadOnly
+	if !readOnly && os.Getenv("TFE_READ_ONLY") != "" {
+		v := os.Getenv("TFE_READ_ONLY")
+		readOnly, err = strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("TFE_READ_ONLY has unrecognized value %q", v)
+		}
+	}
+
+	skipLookupCache := opts.SkipLookupCache
+	if !skipLookupCache && os.Getenv("TFE_SKIP_LOOKUP_CACHE") != "" {
+		v := os.Getenv("TFE_SKIP_LOOKUP_CACHE")
+		skipLookupCache, err = strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("TFE_SKIP_LOOKUP_CACHE has unrecognized value %q", v)
+		}
+	}
+
+	retry := DefaultRetryConfig()
+	if opts.Retry != nil {
+		retry = *opts.Retry
+	}
+	if err := retry.Validate(); err != nil {
+		return nil, err
+	}
+
+	throttle := opts.Throttle
+	if throttle.RequestsPerSecond == 0 && os.Getenv("TFE_REQUESTS_PER_SECOND") != "" {
+		v := os.Getenv("TFE_REQUESTS_PER_SECOND")
+		throttle.RequestsPerSecond, err = strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil, fmt.Errorf("TFE_REQUESTS_PER_SECOND has unrecognized value %q", v)
+		}
+	}
+	if throttle.MaxConcurrentRequests == 0 && os.Getenv("TFE_MAX_CONCURRENT_REQUESTS") != "" {
+		v := os.Getenv("TFE_MAX_CONCURRENT_REQUESTS")
+		throttle.MaxConcurrentRequests, err = strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("TFE_MAX_CONCURRENT_REQUESTS has unrecognized value %q", v)
+		}
+	}
+	if err := throttle.Validate(); err != nil {
+		return nil, err
+	}
+
 	// Parse the hostname for comparison,
 	hostname, err := svchost.ForComparison(tfeHost)
 	if err != nil {
@@ -220,14 +295,36 @@ func configure(tfeHost, token string, insecure bool) (*ClientConfiguration, erro
 
 	transport.TLSClientConfig.InsecureSkipVerify = insecure
 
+	// Add any custom certificate authorities and client certificate. The
+	// transport is shared by service discovery and both go-tfe clients.
+	tlsConfig := opts.TLS.withEnvDefaults()
+	if err := tlsConfig.apply(transport.TLSClientConfig); err != nil {
+		return nil, fmt.Errorf("invalid TLS configuration: %w", err)
+	}
+
+	// Configure the proxy for this configuration only, instead of relying on
+	// the process-wide proxy environment variables.
+	proxyConfig := opts.Proxy.withEnvDefaults()
+	transport.Proxy, err = proxyConfig.proxyFunc()
+	if err != nil {
+		return nil, err
+	}
+
+	// Record or replay the API traffic of service discovery and both go-tfe
+	// clients when a cassette is configured.
+	baseTransport, err := logging.NewCassetteTransport(transport)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cassette configuration: %w", err)
+	}
+
 	// Get the Terraform CLI configuration.
 	config := cliConfig()
 
 	// Create a new credential source and service discovery object.
-	credsSrc := credentialsSource(config.Credentials)
-	services := disco.NewWithCredentialsSource(credsSrc)
+	creds := newCLICredentials(config)
+	services := disco.NewWithCredentialsSource(creds.source())
 	services.SetUserAgent(TFEUserAgent)
-	services.Transport = logging.NewLoggingTransport("TFE", transport)
+	services.Transport = logging.NewLoggingTransport("TFE", baseTransport)
 
 	// Add any static host configurations service discovery object.
 	for userHost, hostConfig := range config.Hosts {
@@ -240,7 +337,9 @@ func configure(tfeHost, token string, insecure bool) (*ClientConfiguration, erro
 	}
 
 	// If a token wasn't set in the provider configuration block, try and fetch it
-	// from the environment or from Terraform's CLI configuration or configured credential helper.
+	// from the environment or, in the same order as the Terraform CLI, from
+	// TF_TOKEN_<host> environment variables, the CLI configuration and
+	// credentials files, or the configured credentials helper.
 
 	tokenSource := providerArgument
 	if token == "" {
@@ -248,8 +347,7 @@ func configure(tfeHost, token string, insecure bool) (*ClientConfiguration, erro
 			token = getTokenFromEnv()
 			tokenSource = environmentVariable
 		} else {
-			token = getTokenFromCreds(services, hostname)
-			tokenSource = credentialFiles
+			token, tokenSource = creds.tokenForHost(hostname)
 		}
 	}
 
@@ -258,6 +356,27 @@ func configure(tfeHost, token string, insecure bool) (*ClientConfiguration, erro
 		return nil, ErrMissingAuthToken
 	}
 
+	// API requests made by the go-tfe clients are throttled and retried
+	// according to the configured policies. Every retry attempt draws from the
+	// throttle budget. Service discovery keeps using the plain transport.
+	apiTransport := baseTransport
+	if throttle.Enabled() {
+		log.Printf("[DEBUG] Throttling API requests to %v per second and %d in flight", throttle.RequestsPerSecond, throttle.MaxConcurrentRequests)
+		apiTransport = newThrottleTransport(sharedThrottle(hostname.String(), token, throttle), apiTransport)
+	}
+	httpClient.Transport = newRetryTransport(retry, apiTransport)
+
+	// Cached lookups are invalidated once per write, however many times it is
+	// retried.
+	lookups := NewLookupCache(DefaultLookupCacheTTL)
+	httpClient.Transport = newLookupInvalidatingTransport(lookups, httpClient.Transport)
+
+	// Refuse writes before they are retried or throttled.
+	if readOnly {
+		log.Printf("[DEBUG] Client configured to be read-only")
+		httpClient.Transport = newReadOnlyTransport(httpClient.Transport)
+	}
+
 	return &ClientConfiguration{
 		Services:    services,
 		HTTPClient:  httpClient,
@@ -265,5 +384,13 @@ func configure(tfeHost, token string, insecure bool) (*ClientConfiguration, erro
 		Token:       token,
 		tokenSource: tokenSource,
 		Insecure:    insecure,
+		Retry:       retry,
+		Throttle:    throttle,
+		TLS:         tlsConfig,
+		Proxy:       proxyConfig,
+		ReadOnly:    readOnly,
+
+		Lookups:         lookups,
+		SkipLookupCache: skipLookupCache,
 	}, nil
 }
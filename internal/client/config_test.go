@@ -193,7 +193,7 @@ func TestConfig_configureSetsTokenSource(t *testing.T) {
 				t.Setenv("TFE_TOKEN", tc.tokenEnvVariable)
 			}
 
-			config, err := configure("app.terraform.io", tc.tokenProviderArgument, true)
+			config, err := configure("app.terraform.io", tc.tokenProviderArgument, true, ClientOptions{})
 
 			if err != nil {
 				t.Fatalf("%s: received error while configuring client: %s", name, err.Error())
@@ -0,0 +1,193 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-tfe/internal/logging"
+	svchost "github.com/hashicorp/terraform-svchost"
+	"github.com/hashicorp/terraform-svchost/auth"
+)
+
+const (
+	// tokenEnvPrefix is the prefix of the TF_TOKEN_<host> environment
+	// variables supported by the Terraform CLI.
+	tokenEnvPrefix = "TF_TOKEN_"
+
+	// credentialsHelperPrefix is the prefix of the executable name of a
+	// Terraform CLI credentials helper.
+	credentialsHelperPrefix = "terraform-credentials-"
+)
+
+// ConfigCredentialsHelper is the structure of the "credentials_helper"
+// nested block within the CLI configuration.
+type ConfigCredentialsHelper struct {
+	Args []string `hcl:"args"`
+}
+
+// cliCredentials holds the credentials sources of the Terraform CLI, which are
+// consulted in the same order as the Terraform CLI does: TF_TOKEN_<host>
+// environment variables first, then credentials blocks from the CLI
+// configuration and credentials files, and finally the credentials helper.
+type cliCredentials struct {
+	env    auth.CredentialsSource
+	static auth.CredentialsSource
+	helper auth.CredentialsSource
+}
+
+// newCLICredentials creates the credentials sources from the environment and
+// the given CLI configuration.
+func newCLICredentials(config CLIHostConfig) cliCredentials {
+	return cliCredentials{
+		env:    credentialsSource(credentialsFromEnv(os.Environ())),
+		static: credentialsSource(config.Credentials),
+		helper: credentialsHelperSource(config.CredentialsHelpers),
+	}
+}
+
+// source returns a single credentials source, used by service discovery.
+func (c cliCredentials) source() auth.CredentialsSource {
+	sources := auth.Credentials{c.env, c.static}
+	if c.helper != nil {
+		sources = append(sources, c.helper)
+	}
+	return sources
+}
+
+// tokenForHost returns the token for the given host along with where it was
+// found. Errors are logged and ignored, like any other missing credentials.
+func (c cliCredentials) tokenForHost(hostname svchost.Hostname) (string, tokenSource) {
+	log.Printf("[DEBUG] Attempting to fetch token from Terraform CLI configuration for configured hostname")
+
+	sources := []struct {
+		source      auth.CredentialsSource
+		tokenSource tokenSource
+	}{
+		{c.env, hostEnvironmentVariable},
+		{c.static, credentialFiles},
+		{c.helper, credentialsHelper},
+	}
+
+	for _, s := range sources {
+		if s.source == nil {
+			continue
+		}
+		creds, err := s.source.ForHost(hostname)
+		if err != nil {
+			log.Printf("[DEBUG] Failed to get credentials for %s: %s (ignoring)", logging.Sanitize(string(hostname)), logging.Sanitize(err.Error())) // nolint:gosec
+			continue
+		}
+		if creds != nil && creds.Token() != "" {
+			return creds.Token(), s.tokenSource
+		}
+	}
+
+	return "", credentialFiles
+}
+
+func credentialsSource(credentials CredentialsMap) auth.CredentialsSource {
+	creds := auth.NoCredentials
+
+	// Add all configured credentials to the credentials source.
+	if len(credentials) > 0 {
+		staticTable := map[svchost.Hostname]map[string]interface{}{}
+		for userHost, creds := range credentials {
+			host, err := svchost.ForComparison(userHost)
+			if err != nil {
+				// We expect the config was already validated by the time we get
+				// here, so we'll just ignore invalid hostnames.
+				continue
+			}
+			staticTable[host] = creds
+		}
+		creds = auth.StaticCredentialsSource(staticTable)
+	}
+
+	return creds
+}
+
+// credentialsFromEnv collects the TF_TOKEN_<host> environment variables.
+// Following the Terraform CLI, a double underscore in the variable name stands
+// for a hyphen and a single underscore for a period, so TF_TOKEN_app_terraform_io
+// holds the token for app.terraform.io.
+func credentialsFromEnv(environ []string) CredentialsMap {
+	credentials := make(CredentialsMap)
+	for _, kv := range environ {
+		name, token, ok := strings.Cut(kv, "=")
+		if !ok || !strings.HasPrefix(name, tokenEnvPrefix) || token == "" {
+			continue
+		}
+
+		rawHost := strings.TrimPrefix(name, tokenEnvPrefix)
+		rawHost = strings.ReplaceAll(rawHost, "__", "-")
+		rawHost = strings.ReplaceAll(rawHost, "_", ".")
+		credentials[rawHost] = map[string]interface{}{"token": token}
+	}
+	return credentials
+}
+
+// credentialsHelperSource returns the credentials source for the configured
+// credentials helper, or nil if there is none or it cannot be found.
+func credentialsHelperSource(helpers map[string]*ConfigCredentialsHelper) auth.CredentialsSource {
+	if len(helpers) == 0 {
+		return nil
+	}
+	if len(helpers) > 1 {
+		log.Printf("[WARN] The CLI configuration declares more than one credentials_helper block, ignoring all of them")
+		return nil
+	}
+
+	for name, helper := range helpers {
+		executable, err := findCredentialsHelper(name)
+		if err != nil {
+			log.Printf("[WARN] Unable to use credentials helper %q: %s", logging.Sanitize(name), logging.Sanitize(err.Error())) // nolint:gosec
+			return nil
+		}
+
+		var args []string
+		if helper != nil {
+			args = helper.Args
+		}
+		log.Printf("[DEBUG] Using credentials helper %s", executable)
+
+		// The helper may be asked for the same host more than once, so cache
+		// its results for the lifetime of this configuration.
+		return auth.CachingCredentialsSource(auth.HelperProgramCredentialsSource(executable, args...))
+	}
+
+	return nil
+}
+
+// findCredentialsHelper looks for the executable of the named credentials
+// helper in the plugin directories searched by the Terraform CLI.
+func findCredentialsHelper(name string) (string, error) {
+	dir, err := configDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate the CLI configuration directory: %w", err)
+	}
+
+	filename := credentialsHelperPrefix + name
+	if runtime.GOOS == "windows" {
+		filename += ".exe"
+	}
+
+	pluginDir := filepath.Join(dir, "plugins")
+	candidates := []string{
+		filepath.Join(pluginDir, filename),
+		filepath.Join(pluginDir, runtime.GOOS+"_"+runtime.GOARCH, filename),
+	}
+	for _, candidate := range candidates {
+		if info, err := os.Stat(candidate); err == nil && !info.IsDir() {
+			return filepath.Abs(candidate)
+		}
+	}
+
+	return "", fmt.Errorf("%s not found in %s", filename, pluginDir)
+}
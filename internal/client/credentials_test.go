@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	svchost "github.com/hashicorp/terraform-svchost"
+)
+
+func TestCredentials_credentialsFromEnv(t *testing.T) {
+	creds := credentialsFromEnv([]string{
+		"TF_TOKEN_app_terraform_io=app-token",
+		"TF_TOKEN_tfe__internal_example_com=internal-token",
+		"TF_TOKEN_empty_example_com=",
+		"TFE_TOKEN=not-a-host-token",
+	})
+
+	if len(creds) != 2 {
+		t.Fatalf("expected 2 credentials, got %d: %v", len(creds), creds)
+	}
+	if got := creds["app.terraform.io"]["token"]; got != "app-token" {
+		t.Fatalf("expected app.terraform.io token, got %v", got)
+	}
+	if got := creds["tfe-internal.example.com"]["token"]; got != "internal-token" {
+		t.Fatalf("expected tfe-internal.example.com token, got %v", got)
+	}
+}
+
+func TestCredentials_tokenForHost(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("credentials helper test uses a shell script")
+	}
+
+	// Install a credentials helper that returns a token for any host.
+	home := t.TempDir()
+	pluginDir := filepath.Join(home, ".terraform.d", "plugins")
+	if err := os.MkdirAll(pluginDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	helper := "#!/bin/sh\necho '{\"token\":\"helper-token\"}'\n"
+	if err := os.WriteFile(filepath.Join(pluginDir, "terraform-credentials-test"), []byte(helper), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("HOME", home)
+
+	host := svchost.Hostname("tfe.example.com")
+	withHelper := map[string]*ConfigCredentialsHelper{"test": {}}
+	withStatic := CredentialsMap{"tfe.example.com": {"token": "static-token"}}
+
+	cases := map[string]struct {
+		env               string
+		config            CLIHostConfig
+		expectToken       string
+		expectTokenSource tokenSource
+	}{
+		"TF_TOKEN env takes precedence": {
+			env:               "env-token",
+			config:            CLIHostConfig{Credentials: withStatic, CredentialsHelpers: withHelper},
+			expectToken:       "env-token",
+			expectTokenSource: hostEnvironmentVariable,
+		},
+		"credentials block before helper": {
+			config:            CLIHostConfig{Credentials: withStatic, CredentialsHelpers: withHelper},
+			expectToken:       "static-token",
+			expectTokenSource: credentialFiles,
+		},
+		"credentials helper": {
+			config:            CLIHostConfig{CredentialsHelpers: withHelper},
+			expectToken:       "helper-token",
+			expectTokenSource: credentialsHelper,
+		},
+		"missing credentials helper": {
+			config:            CLIHostConfig{CredentialsHelpers: map[string]*ConfigCredentialsHelper{"missing": {}}},
+			expectToken:       "",
+			expectTokenSource: credentialFiles,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("TF_TOKEN_tfe_example_com", tc.env)
+
+			token, source := newCLICredentials(tc.config).tokenForHost(host)
+			if token != tc.expectToken {
+				t.Fatalf("expected token %q, got %q", tc.expectToken, token)
+			}
+			if source != tc.expectTokenSource {
+				t.Fatalf("expected token source %d, got %d", tc.expectTokenSource, source)
+			}
+		})
+	}
+}
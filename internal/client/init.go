@@ -16,6 +16,8 @@ func init() {
 	clientCache = &ClientConfigMap{
 		valuesV1: make(map[string]*tfe.Client),
 		values:   make(map[string]*tfev2.Client),
+		lookups:  make(map[string]*LookupCache),
+		services: make(map[string]map[string]string),
 		mu:       sync.Mutex{},
 	}
 }
@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Lookup kinds are the API path segments, mostly JSON:API types, of the objects
+// whose lookups are cached. A write to any API path containing the kind as a
+// segment, such as /api/v2/organizations/hashicorp/teams or
+// /api/v2/teams/team-123, invalidates every cached lookup of that kind.
+const (
+	LookupAgentPools              = "agent-pools"
+	LookupEntitlementSet          = "entitlement-set"
+	LookupOrganizationMemberships = "organization-memberships"
+	LookupTeams                   = "teams"
+	LookupTerraformVersions       = "terraform-versions"
+	LookupWorkspaces              = "workspaces"
+)
+
+// DefaultLookupCacheTTL is how long a cached lookup is reused. It is short
+// enough that objects changed outside of Terraform are picked up by the next
+// plan, but long enough to cover a plan of many resources.
+const DefaultLookupCacheTTL = 2 * time.Minute
+
+// LookupCache is a read-through cache for the name to ID lookups of the
+// provider, shared by all resources and data sources using the same clients.
+// A nil *LookupCache is valid and caches nothing.
+type LookupCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[lookupKey]lookupEntry
+}
+
+type lookupKey struct {
+	kind string
+	key  string
+}
+
+type lookupEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+// NewLookupCache creates an empty cache whose entries expire after ttl.
+func NewLookupCache(ttl time.Duration) *LookupCache {
+	return &LookupCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[lookupKey]lookupEntry),
+	}
+}
+
+// Lookup returns the cached value of the given kind and key, or calls fetch
+// and caches its result. Errors are never cached.
+func Lookup[T any](c *LookupCache, kind, key string, fetch func() (T, error)) (T, error) {
+	if v, ok := c.get(kind, key); ok {
+		if value, ok := v.(T); ok {
+			return value, nil
+		}
+	}
+
+	value, err := fetch()
+	if err != nil {
+		return value, err
+	}
+
+	c.set(kind, key, value)
+	return value, nil
+}
+
+func (c *LookupCache) get(kind, key string) (interface{}, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	k := lookupKey{kind: kind, key: key}
+	entry, ok := c.entries[k]
+	if !ok {
+		return nil, false
+	}
+	if !c.now().Before(entry.expires) {
+		delete(c.entries, k)
+		return nil, false
+	}
+	return entry.value, true
+}
+
+func (c *LookupCache) set(kind, key string, value interface{}) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[lookupKey{kind: kind, key: key}] = lookupEntry{value: value, expires: c.now().Add(c.ttl)}
+}
+
+// Invalidate drops every cached lookup of the given kind.
+func (c *LookupCache) Invalidate(kind string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for k := range c.entries {
+		if k.kind == kind {
+			delete(c.entries, k)
+		}
+	}
+}
+
+// invalidatePath drops the cached lookups of every kind named by a segment of
+// the given API path.
+func (c *LookupCache) invalidatePath(path string) {
+	for _, segment := range strings.Split(path, "/") {
+		switch segment {
+		case LookupAgentPools, LookupEntitlementSet, LookupOrganizationMemberships, LookupTeams, LookupTerraformVersions, LookupWorkspaces:
+			log.Printf("[TRACE] Invalidating cached %s lookups", segment)
+			c.Invalidate(segment)
+		}
+	}
+}
+
+// lookupInvalidatingTransport invalidates the cached lookups affected by
+// every request that may change an object.
+type lookupInvalidatingTransport struct {
+	cache    *LookupCache
+	delegate http.RoundTripper
+}
+
+func newLookupInvalidatingTransport(cache *LookupCache, delegate http.RoundTripper) http.RoundTripper {
+	return &lookupInvalidatingTransport{cache: cache, delegate: delegate}
+}
+
+func (t *lookupInvalidatingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.delegate.RoundTrip(req)
+
+	// Invalidate even when the request failed, since it may still have
+	// changed the object.
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		t.cache.invalidatePath(req.URL.Path)
+	}
+
+	return resp, err
+}
@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestLookupCache_Lookup(t *testing.T) {
+	now := time.Now()
+	cache := NewLookupCache(time.Minute)
+	cache.now = func() time.Time { return now }
+
+	var calls int
+	fetch := func() (string, error) {
+		calls++
+		return "team-123", nil
+	}
+
+	for i := 0; i < 3; i++ {
+		id, err := Lookup(cache, LookupTeams, "hashicorp/owners", fetch)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if id != "team-123" {
+			t.Fatalf("expected team-123, got %q", id)
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("expected 1 fetch, got %d", calls)
+	}
+
+	if _, err := Lookup(cache, LookupTeams, "hashicorp/admins", fetch); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected a fetch for a different key, got %d fetches", calls)
+	}
+
+	now = now.Add(time.Minute)
+	if _, err := Lookup(cache, LookupTeams, "hashicorp/owners", fetch); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if calls != 3 {
+		t.Fatalf("expected a fetch after the entry expired, got %d fetches", calls)
+	}
+}
+
+func TestLookupCache_errorsAreNotCached(t *testing.T) {
+	cache := NewLookupCache(time.Minute)
+
+	var calls int
+	fetch := func() (string, error) {
+		calls++
+		return "", errors.New("not found")
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := Lookup(cache, LookupTeams, "hashicorp/owners", fetch); err == nil {
+			t.Fatal("expected an error")
+		}
+	}
+	if calls != 2 {
+		t.Fatalf("expected 2 fetches, got %d", calls)
+	}
+}
+
+func TestLookupCache_nil(t *testing.T) {
+	var cache *LookupCache
+
+	var calls int
+	fetch := func() (string, error) {
+		calls++
+		return "team-123", nil
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := Lookup(cache, LookupTeams, "hashicorp/owners", fetch); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	if calls != 2 {
+		t.Fatalf("expected every lookup to fetch, got %d fetches", calls)
+	}
+
+	cache.Invalidate(LookupTeams)
+}
+
+func TestLookupInvalidatingTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	cases := map[string]struct {
+		method      string
+		path        string
+		invalidated []string
+	}{
+		"read": {
+			method: http.MethodGet,
+			path:   "/api/v2/organizations/hashicorp/teams",
+		},
+		"create": {
+			method:      http.MethodPost,
+			path:        "/api/v2/organizations/hashicorp/teams",
+			invalidated: []string{LookupTeams},
+		},
+		"delete": {
+			method:      http.MethodDelete,
+			path:        "/api/v2/workspaces/ws-123",
+			invalidated: []string{LookupWorkspaces},
+		},
+		"update of another kind": {
+			method: http.MethodPatch,
+			path:   "/api/v2/projects/prj-123",
+		},
+		"relationship": {
+			method:      http.MethodPost,
+			path:        "/api/v2/teams/team-123/relationships/organization-memberships",
+			invalidated: []string{LookupTeams, LookupOrganizationMemberships},
+		},
+	}
+
+	kinds := []string{LookupAgentPools, LookupOrganizationMemberships, LookupTeams, LookupTerraformVersions, LookupWorkspaces}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cache := NewLookupCache(time.Minute)
+			for _, kind := range kinds {
+				cache.set(kind, "key", "value")
+			}
+
+			req, err := http.NewRequest(tc.method, server.URL+tc.path, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := newLookupInvalidatingTransport(cache, http.DefaultTransport).RoundTrip(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			resp.Body.Close()
+
+			invalidated := make(map[string]bool)
+			for _, kind := range tc.invalidated {
+				invalidated[kind] = true
+			}
+			for _, kind := range kinds {
+				if _, ok := cache.get(kind, "key"); ok == invalidated[kind] {
+					t.Errorf("expected %s lookups to be invalidated: %v", kind, invalidated[kind])
+				}
+			}
+		})
+	}
+}
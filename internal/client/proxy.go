@@ -0,0 +1,95 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+// ProxyConfig is the HTTP(S) proxy used to reach HCP Terraform or Terraform
+// Enterprise. When URL is empty, the standard HTTPS_PROXY, HTTP_PROXY and
+// NO_PROXY environment variables are used instead.
+type ProxyConfig struct {
+	URL      string
+	NoProxy  string
+	Username string
+	Password string
+}
+
+// withEnvDefaults fills in the values that were not set in the provider
+// configuration from the environment.
+func (c ProxyConfig) withEnvDefaults() ProxyConfig {
+	fields := []struct {
+		target *string
+		env    string
+	}{
+		{&c.URL, "TFE_PROXY_URL"},
+		{&c.NoProxy, "TFE_NO_PROXY"},
+		{&c.Username, "TFE_PROXY_USERNAME"},
+		{&c.Password, "TFE_PROXY_PASSWORD"},
+	}
+	for _, f := range fields {
+		if *f.target == "" {
+			*f.target = os.Getenv(f.env)
+		}
+	}
+	return c
+}
+
+// String returns a stable representation of the proxy settings, suitable for
+// use in a client cache key. The password is hashed.
+func (c ProxyConfig) String() string {
+	h := sha256.Sum256([]byte(c.Password))
+	return fmt.Sprintf("%s|%s|%s|%x", c.URL, c.NoProxy, c.Username, h[:])
+}
+
+// proxyFunc returns the function used by http.Transport to select a proxy for
+// each request.
+func (c ProxyConfig) proxyFunc() (func(*http.Request) (*url.URL, error), error) {
+	if c.URL == "" {
+		if c.Username != "" || c.Password != "" || c.NoProxy != "" {
+			return nil, fmt.Errorf("proxy_url must be set when proxy_username, proxy_password or no_proxy are set")
+		}
+		return http.ProxyFromEnvironment, nil
+	}
+
+	proxyURL, err := url.Parse(c.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy_url: %w", err)
+	}
+	switch proxyURL.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf("invalid proxy_url %q: the scheme must be http, https or socks5", proxyURL.Redacted())
+	}
+	if proxyURL.Host == "" {
+		return nil, fmt.Errorf("invalid proxy_url %q: missing host", proxyURL.Redacted())
+	}
+
+	// The transport sends the credentials in the Proxy-Authorization header
+	// when they are part of the proxy URL.
+	if c.Username != "" {
+		proxyURL.User = url.UserPassword(c.Username, c.Password)
+	}
+
+	log.Printf("[DEBUG] Client configured to use proxy %s", proxyURL.Redacted())
+
+	config := &httpproxy.Config{
+		HTTPProxy:  proxyURL.String(),
+		HTTPSProxy: proxyURL.String(),
+		NoProxy:    c.NoProxy,
+	}
+	proxy := config.ProxyFunc()
+
+	return func(req *http.Request) (*url.URL, error) {
+		return proxy(req.URL)
+	}, nil
+}
@@ -0,0 +1,44 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// ReadOnlyError is returned for requests refused because the provider is
+// configured to be read-only.
+type ReadOnlyError struct {
+	Method string
+	Path   string
+}
+
+func (e *ReadOnlyError) Error() string {
+	return fmt.Sprintf("refusing to send %s %s because the provider is in read-only mode; unset read_only or TFE_READ_ONLY to allow changes", e.Method, e.Path)
+}
+
+// readOnlyTransport refuses every request that is not a GET before it reaches
+// the network, so that a provider configured to be read-only cannot change
+// anything, whichever resource or data source issues the request.
+type readOnlyTransport struct {
+	delegate http.RoundTripper
+}
+
+// newReadOnlyTransport wraps the given transport so that only GET requests
+// are sent.
+func newReadOnlyTransport(t http.RoundTripper) *readOnlyTransport {
+	return &readOnlyTransport{delegate: t}
+}
+
+func (t *readOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		// A RoundTripper must always close the request body.
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, &ReadOnlyError{Method: req.Method, Path: req.URL.Path}
+	}
+	return t.delegate.RoundTrip(req)
+}
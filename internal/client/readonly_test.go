@@ -0,0 +1,60 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestReadOnlyTransport(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+	}))
+	t.Cleanup(srv.Close)
+
+	client := &http.Client{Transport: newReadOnlyTransport(http.DefaultTransport)}
+
+	resp, err := client.Get(srv.URL + "/api/v2/organizations")
+	if err != nil {
+		t.Fatalf("expected GET to be sent, got %v", err)
+	}
+	resp.Body.Close()
+
+	for _, method := range []string{http.MethodPost, http.MethodPatch, http.MethodPut, http.MethodDelete} {
+		req, err := http.NewRequest(method, srv.URL+"/api/v2/workspaces/ws-123", strings.NewReader("{}"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = client.Do(req)
+
+		var readOnlyErr *ReadOnlyError
+		if !errors.As(err, &readOnlyErr) {
+			t.Fatalf("expected %s to be refused with a ReadOnlyError, got %v", method, err)
+		}
+		if readOnlyErr.Method != method || readOnlyErr.Path != "/api/v2/workspaces/ws-123" {
+			t.Fatalf("expected the error to name %s /api/v2/workspaces/ws-123, got %q", method, readOnlyErr.Error())
+		}
+	}
+
+	if calls != 1 {
+		t.Fatalf("expected only the GET request to reach the server, got %d requests", calls)
+	}
+}
+
+func TestClientConfiguration_KeyIncludesReadOnly(t *testing.T) {
+	config := ClientConfiguration{TFEHost: "app.terraform.io", Token: "secret"}
+	readOnly := config
+	readOnly.ReadOnly = true
+
+	if config.Key() == readOnly.Key() {
+		t.Fatal("expected read-only configurations to use a different client")
+	}
+}
@@ -0,0 +1,241 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-tfe/internal/logging"
+)
+
+const (
+	defaultRetryMaxRetries = 10
+	defaultRetryMinBackoff = 100 * time.Millisecond
+	defaultRetryMaxBackoff = 30 * time.Second
+
+	headerRateReset = "X-RateLimit-Reset"
+)
+
+// RetryConfig is the policy used to retry API requests that were rate limited
+// or failed with a server error. The same policy is applied to both the go-tfe
+// v1 and v2 clients.
+type RetryConfig struct {
+	// MaxRetries is the number of times a request is retried before giving up.
+	MaxRetries int
+
+	// MinBackoff and MaxBackoff bound the exponential backoff between retries.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+
+	// RetryRateLimited enables retrying requests that received a 429 response.
+	RetryRateLimited bool
+
+	// RetryServerErrors enables retrying requests that received a 5xx response
+	// or failed with a connection error. Connection errors are only retried
+	// for idempotent requests, or when the request was never sent.
+	RetryServerErrors bool
+
+	// Deadline is the total time a request may spend being retried. A zero
+	// value means there is no deadline.
+	Deadline time.Duration
+}
+
+// DefaultRetryConfig returns the retry policy used when the provider
+// configuration does not specify one.
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxRetries:        defaultRetryMaxRetries,
+		MinBackoff:        defaultRetryMinBackoff,
+		MaxBackoff:        defaultRetryMaxBackoff,
+		RetryRateLimited:  true,
+		RetryServerErrors: true,
+	}
+}
+
+// Validate checks that the retry policy values are usable.
+func (c RetryConfig) Validate() error {
+	if c.MaxRetries < 0 {
+		return fmt.Errorf("retry max_retries must not be negative, got %d", c.MaxRetries)
+	}
+	if c.MinBackoff < 0 || c.MaxBackoff < 0 || c.Deadline < 0 {
+		return fmt.Errorf("retry backoff and deadline durations must not be negative")
+	}
+	if c.MaxBackoff < c.MinBackoff {
+		return fmt.Errorf("retry max_backoff (%s) must not be less than min_backoff (%s)", c.MaxBackoff, c.MinBackoff)
+	}
+	return nil
+}
+
+// String returns a stable representation of the retry policy, suitable for
+// use in a client cache key.
+func (c RetryConfig) String() string {
+	return fmt.Sprintf("%d/%s/%s/%t/%t/%s", c.MaxRetries, c.MinBackoff, c.MaxBackoff, c.RetryRateLimited, c.RetryServerErrors, c.Deadline)
+}
+
+// backoff returns how long to wait before the given retry attempt (starting
+// at zero). Rate limited responses wait at least until the time reported in
+// the X-RateLimit-Reset header.
+func (c RetryConfig) backoff(attempt int, resp *http.Response) time.Duration {
+	wait := c.MaxBackoff
+	if attempt < 32 {
+		if exp := c.MinBackoff << uint(attempt); exp > 0 && exp < c.MaxBackoff {
+			wait = exp
+		}
+	}
+
+	// Add up to 25% of jitter to prevent a thundering herd.
+	if wait > 0 {
+		wait += time.Duration(rand.Int63n(int64(wait)/4 + 1))
+	}
+
+	if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+		if v := resp.Header.Get(headerRateReset); v != "" {
+			if reset, err := strconv.ParseFloat(v, 64); err == nil && time.Duration(reset*float64(time.Second)) > wait {
+				wait = time.Duration(reset * float64(time.Second))
+			}
+		}
+	}
+
+	return wait
+}
+
+// shouldRetry reports whether the request should be retried after the given
+// response or error. Connection errors are only retried when the request is
+// idempotent or was never sent, so that a POST the server may have processed
+// is not replayed.
+func (c RetryConfig) shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if err != nil {
+		return c.RetryServerErrors && (isIdempotent(req.Method) || requestNotSent(err))
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return c.RetryRateLimited
+	}
+	return c.RetryServerErrors && resp.StatusCode >= 500
+}
+
+// isIdempotent reports whether requests with the given method can safely be
+// sent more than once.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// requestNotSent reports whether the error happened before the request could
+// be sent, such as when resolving the host or connecting to it failed.
+func requestNotSent(err error) bool {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// retryTransport retries requests according to a RetryConfig. It is shared by
+// both go-tfe clients so that they follow the same policy; their own retry
+// logic is disabled.
+type retryTransport struct {
+	config   RetryConfig
+	delegate http.RoundTripper
+}
+
+// newRetryTransport wraps the given transport with the retry policy.
+func newRetryTransport(config RetryConfig, t http.RoundTripper) *retryTransport {
+	return &retryTransport{config: config, delegate: t}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+
+	// Make sure the body can be replayed when the request is retried.
+	getBody := req.GetBody
+	if req.Body != nil && req.Body != http.NoBody && getBody == nil {
+		b, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		getBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(b)), nil
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		r := req
+		if getBody != nil && (attempt > 0 || req.GetBody == nil) {
+			body, err := getBody()
+			if err != nil {
+				return nil, err
+			}
+			r = req.Clone(req.Context())
+			r.Body = body
+		}
+
+		resp, err := t.delegate.RoundTrip(r)
+		if req.Context().Err() != nil {
+			return resp, err
+		}
+
+		if !t.config.shouldRetry(req, resp, err) || attempt >= t.config.MaxRetries {
+			return t.giveUp(req, resp, err, attempt)
+		}
+
+		wait := t.config.backoff(attempt, resp)
+		if t.config.Deadline > 0 && time.Since(start)+wait > t.config.Deadline {
+			log.Printf("[DEBUG] Retry deadline of %s reached for %s %s", t.config.Deadline, req.Method, logging.Sanitize(req.URL.Path)) // nolint:gosec
+			return t.giveUp(req, resp, err, attempt)
+		}
+
+		if resp != nil {
+			if resp.StatusCode == http.StatusTooManyRequests {
+				log.Printf("[DEBUG] Rate limited by TFE API, retrying request (attempt %d)", attempt+1)
+			} else {
+				log.Printf("[DEBUG] TFE API returned %d, retrying request (attempt %d)", resp.StatusCode, attempt+1)
+			}
+			// Drain the body so the connection can be reused.
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		} else {
+			log.Printf("[DEBUG] TFE API request failed, retrying request (attempt %d): %s", attempt+1, logging.Sanitize(err.Error())) // nolint:gosec
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// giveUp returns the final result of a request that will not be retried
+// anymore. Rate limited responses are turned into an error, because go-tfe v1
+// unconditionally retries 429 responses and would otherwise ignore the
+// configured policy.
+func (t *retryTransport) giveUp(req *http.Request, resp *http.Response, err error, attempt int) (*http.Response, error) {
+	if err != nil || resp.StatusCode != http.StatusTooManyRequests {
+		return resp, err
+	}
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+
+	if !t.config.RetryRateLimited {
+		return nil, fmt.Errorf("rate limited by TFE API on %s %s and retrying rate limited requests is disabled", req.Method, req.URL.Path)
+	}
+	return nil, fmt.Errorf("rate limited by TFE API on %s %s, giving up after %d retries", req.Method, req.URL.Path, attempt)
+}
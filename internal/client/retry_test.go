@@ -0,0 +1,252 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func testRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxRetries:        3,
+		MinBackoff:        time.Millisecond,
+		MaxBackoff:        5 * time.Millisecond,
+		RetryRateLimited:  true,
+		RetryServerErrors: true,
+	}
+}
+
+func testRetryServer(t *testing.T, statuses ...int) (*httptest.Server, *int32) {
+	t.Helper()
+
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&calls, 1)
+		body, _ := io.ReadAll(r.Body)
+		if int(n) <= len(statuses) {
+			w.WriteHeader(statuses[n-1])
+			return
+		}
+		w.Write(body)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, &calls
+}
+
+func TestRetryTransport(t *testing.T) {
+	cases := map[string]struct {
+		config      func(RetryConfig) RetryConfig
+		statuses    []int
+		expectCalls int32
+		expectCode  int
+		expectErr   string
+	}{
+		"succeeds without retries": {
+			expectCalls: 1,
+			expectCode:  http.StatusOK,
+		},
+		"retries rate limited requests": {
+			statuses:    []int{http.StatusTooManyRequests, http.StatusTooManyRequests},
+			expectCalls: 3,
+			expectCode:  http.StatusOK,
+		},
+		"retries server errors": {
+			statuses:    []int{http.StatusBadGateway},
+			expectCalls: 2,
+			expectCode:  http.StatusOK,
+		},
+		"does not retry client errors": {
+			statuses:    []int{http.StatusNotFound},
+			expectCalls: 1,
+			expectCode:  http.StatusNotFound,
+		},
+		"returns the last server error after max retries": {
+			statuses:    []int{500, 500, 500, 500, 500},
+			expectCalls: 4,
+			expectCode:  http.StatusInternalServerError,
+		},
+		"returns an error when rate limited after max retries": {
+			statuses:    []int{429, 429, 429, 429, 429},
+			expectCalls: 4,
+			expectErr:   "giving up after 3 retries",
+		},
+		"server error retries disabled": {
+			config: func(c RetryConfig) RetryConfig {
+				c.RetryServerErrors = false
+				return c
+			},
+			statuses:    []int{http.StatusServiceUnavailable},
+			expectCalls: 1,
+			expectCode:  http.StatusServiceUnavailable,
+		},
+		"rate limited retries disabled": {
+			config: func(c RetryConfig) RetryConfig {
+				c.RetryRateLimited = false
+				return c
+			},
+			statuses:    []int{http.StatusTooManyRequests},
+			expectCalls: 1,
+			expectErr:   "retrying rate limited requests is disabled",
+		},
+		"stops retrying at the deadline": {
+			config: func(c RetryConfig) RetryConfig {
+				c.MinBackoff = 50 * time.Millisecond
+				c.MaxBackoff = 50 * time.Millisecond
+				c.Deadline = 10 * time.Millisecond
+				return c
+			},
+			statuses:    []int{http.StatusBadGateway, http.StatusBadGateway},
+			expectCalls: 1,
+			expectCode:  http.StatusBadGateway,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			srv, calls := testRetryServer(t, tc.statuses...)
+
+			config := testRetryConfig()
+			if tc.config != nil {
+				config = tc.config(config)
+			}
+			httpClient := &http.Client{Transport: newRetryTransport(config, http.DefaultTransport)}
+
+			resp, err := httpClient.Post(srv.URL, "application/json", strings.NewReader(`{"data":{}}`))
+			if tc.expectErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.expectErr) {
+					t.Fatalf("expected error containing %q, got %v", tc.expectErr, err)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				defer resp.Body.Close()
+
+				if resp.StatusCode != tc.expectCode {
+					t.Fatalf("expected status %d, got %d", tc.expectCode, resp.StatusCode)
+				}
+				if resp.StatusCode == http.StatusOK {
+					body, _ := io.ReadAll(resp.Body)
+					if string(body) != `{"data":{}}` {
+						t.Fatalf("expected request body to be replayed, got %q", body)
+					}
+				}
+			}
+
+			if got := atomic.LoadInt32(calls); got != tc.expectCalls {
+				t.Fatalf("expected %d calls, got %d", tc.expectCalls, got)
+			}
+		})
+	}
+}
+
+func TestRetryTransport_connectionErrors(t *testing.T) {
+	// The server reads the request and then drops the connection, so the
+	// request was sent but may have been processed.
+	dropping := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Errorf("unexpected error hijacking the connection: %v", err)
+			return
+		}
+		conn.Close()
+	}))
+	t.Cleanup(dropping.Close)
+
+	// A closed server refuses connections, so requests are never sent.
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closed.Close()
+
+	cases := map[string]struct {
+		method      string
+		url         string
+		expectCalls int32
+	}{
+		"retries an idempotent request": {
+			method:      http.MethodGet,
+			url:         dropping.URL,
+			expectCalls: 4,
+		},
+		"does not replay a sent POST": {
+			method:      http.MethodPost,
+			url:         dropping.URL,
+			expectCalls: 1,
+		},
+		"retries a POST that was never sent": {
+			method:      http.MethodPost,
+			url:         closed.URL,
+			expectCalls: 4,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var attempts int32
+			transport := http.DefaultTransport.(*http.Transport).Clone()
+			transport.DisableKeepAlives = true
+			counting := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				atomic.AddInt32(&attempts, 1)
+				return transport.RoundTrip(req)
+			})
+			httpClient := &http.Client{Transport: newRetryTransport(testRetryConfig(), counting)}
+
+			req, err := http.NewRequest(tc.method, tc.url, strings.NewReader(`{"data":{}}`))
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := httpClient.Do(req)
+			if err == nil {
+				resp.Body.Close()
+				t.Fatal("expected a connection error")
+			}
+
+			if got := atomic.LoadInt32(&attempts); got != tc.expectCalls {
+				t.Fatalf("expected %d attempts, got %d", tc.expectCalls, got)
+			}
+		})
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRetryConfig_Validate(t *testing.T) {
+	valid := DefaultRetryConfig()
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("expected default retry config to be valid, got %v", err)
+	}
+
+	negative := DefaultRetryConfig()
+	negative.MaxRetries = -1
+	if err := negative.Validate(); err == nil {
+		t.Fatal("expected an error for negative max retries")
+	}
+
+	inverted := DefaultRetryConfig()
+	inverted.MinBackoff = time.Minute
+	inverted.MaxBackoff = time.Second
+	if err := inverted.Validate(); err == nil {
+		t.Fatal("expected an error when max backoff is less than min backoff")
+	}
+}
+
+func TestClientConfiguration_KeyIncludesRetry(t *testing.T) {
+	a := ClientConfiguration{TFEHost: "app.terraform.io", Token: testToken, Retry: DefaultRetryConfig()}
+	b := a
+	b.Retry.MaxRetries = 2
+
+	if a.Key() == b.Key() {
+		t.Fatal("expected configurations with different retry policies to have different keys")
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// ThrottleConfig limits the rate and concurrency of API requests made by the
+// provider. Zero values disable the corresponding limit.
+type ThrottleConfig struct {
+	// RequestsPerSecond is the sustained request rate allowed by the token
+	// bucket. Bursts of up to one second worth of requests are allowed.
+	RequestsPerSecond float64
+
+	// MaxConcurrentRequests caps the number of requests in flight at once.
+	MaxConcurrentRequests int
+}
+
+// Validate checks that the throttle values are usable.
+func (c ThrottleConfig) Validate() error {
+	if c.RequestsPerSecond < 0 || math.IsNaN(c.RequestsPerSecond) || math.IsInf(c.RequestsPerSecond, 0) {
+		return fmt.Errorf("requests_per_second must be a positive number, got %v", c.RequestsPerSecond)
+	}
+	if c.MaxConcurrentRequests < 0 {
+		return fmt.Errorf("max_concurrent_requests must not be negative, got %d", c.MaxConcurrentRequests)
+	}
+	return nil
+}
+
+// Enabled reports whether any limit is configured.
+func (c ThrottleConfig) Enabled() bool {
+	return c.RequestsPerSecond > 0 || c.MaxConcurrentRequests > 0
+}
+
+// String returns a stable representation of the throttle settings, suitable
+// for use in a client cache key.
+func (c ThrottleConfig) String() string {
+	return fmt.Sprintf("%v/%d", c.RequestsPerSecond, c.MaxConcurrentRequests)
+}
+
+// throttle is the request budget shared by every client talking to the same
+// host with the same token.
+type throttle struct {
+	limiter *rate.Limiter
+
+	// mu guards the concurrency limit and the requests in flight. wake is
+	// closed and replaced whenever a request finishes, to wake up the
+	// requests waiting for a slot.
+	mu            sync.Mutex
+	maxConcurrent int
+	inFlight      int
+	wake          chan struct{}
+}
+
+func newThrottle(config ThrottleConfig) *throttle {
+	t := &throttle{
+		limiter: rate.NewLimiter(rate.Inf, 0),
+		wake:    make(chan struct{}),
+	}
+	t.tighten(config)
+	return t
+}
+
+// tighten applies the limits of the given settings that are stricter than
+// the current ones, so that the budget always honors the strictest settings
+// configured for the host and token.
+func (t *throttle) tighten(config ThrottleConfig) {
+	if config.RequestsPerSecond > 0 && rate.Limit(config.RequestsPerSecond) < t.limiter.Limit() {
+		t.limiter.SetLimit(rate.Limit(config.RequestsPerSecond))
+		t.limiter.SetBurst(int(math.Ceil(config.RequestsPerSecond)))
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if config.MaxConcurrentRequests > 0 && (t.maxConcurrent == 0 || config.MaxConcurrentRequests < t.maxConcurrent) {
+		t.maxConcurrent = config.MaxConcurrentRequests
+	}
+}
+
+// acquire waits for a free concurrency slot.
+func (t *throttle) acquire(ctx context.Context) error {
+	for {
+		t.mu.Lock()
+		if t.maxConcurrent == 0 || t.inFlight < t.maxConcurrent {
+			t.inFlight++
+			t.mu.Unlock()
+			return nil
+		}
+		wake := t.wake
+		t.mu.Unlock()
+
+		select {
+		case <-wake:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// release frees the concurrency slot of a finished request.
+func (t *throttle) release() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.inFlight--
+	close(t.wake)
+	t.wake = make(chan struct{})
+}
+
+var (
+	throttlesMu sync.Mutex
+	throttles   = make(map[string]*throttle)
+)
+
+// sharedThrottle returns the throttle for the given host and token, creating
+// it when needed. Both go-tfe clients and every provider configuration with
+// the same host and token draw from the same budget, limited by the strictest
+// of their settings.
+func sharedThrottle(host, token string, config ThrottleConfig) *throttle {
+	h := sha256.Sum256([]byte(token))
+	key := fmt.Sprintf("%x %s", h[:], host)
+
+	throttlesMu.Lock()
+	defer throttlesMu.Unlock()
+
+	t, ok := throttles[key]
+	if !ok {
+		t = newThrottle(config)
+		throttles[key] = t
+		return t
+	}
+	t.tighten(config)
+	return t
+}
+
+// throttleTransport waits for the shared rate limiter and a free concurrency
+// slot before sending each request. Waiting is cheaper than being rate limited
+// by the API and backing off.
+type throttleTransport struct {
+	throttle *throttle
+	delegate http.RoundTripper
+}
+
+// newThrottleTransport wraps the given transport with the throttle.
+func newThrottleTransport(throttle *throttle, t http.RoundTripper) *throttleTransport {
+	return &throttleTransport{throttle: throttle, delegate: t}
+}
+
+func (t *throttleTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	if err := t.throttle.acquire(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := t.throttle.limiter.Wait(ctx); err != nil {
+		t.throttle.release()
+		return nil, err
+	}
+
+	resp, err := t.delegate.RoundTrip(req)
+	if err != nil || resp == nil || resp.Body == nil {
+		t.throttle.release()
+		return resp, err
+	}
+
+	// Keep the slot until the response body has been consumed.
+	resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: t.throttle.release}
+	return resp, nil
+}
+
+// releaseOnClose releases a concurrency slot the first time the body is
+// closed.
+type releaseOnClose struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (r *releaseOnClose) Close() error {
+	err := r.ReadCloser.Close()
+	r.once.Do(r.release)
+	return err
+}
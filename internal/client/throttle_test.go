@@ -0,0 +1,110 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestThrottleTransport_maxConcurrentRequests(t *testing.T) {
+	var inFlight, maxInFlight int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			m := atomic.LoadInt32(&maxInFlight)
+			if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+	}))
+	t.Cleanup(srv.Close)
+
+	throttle := newThrottle(ThrottleConfig{MaxConcurrentRequests: 2})
+	httpClient := &http.Client{Transport: newThrottleTransport(throttle, http.DefaultTransport)}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := httpClient.Get(srv.URL)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&maxInFlight); got > 2 {
+		t.Fatalf("expected at most 2 requests in flight, got %d", got)
+	}
+}
+
+func TestThrottleTransport_requestsPerSecond(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	t.Cleanup(srv.Close)
+
+	throttle := newThrottle(ThrottleConfig{RequestsPerSecond: 20})
+	httpClient := &http.Client{Transport: newThrottleTransport(throttle, http.DefaultTransport)}
+
+	// The first 20 requests use the burst, the next 10 are spread out at 20
+	// requests per second.
+	start := time.Now()
+	for i := 0; i < 30; i++ {
+		resp, err := httpClient.Get(srv.URL)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond {
+		t.Fatalf("expected requests to be rate limited, finished in %s", elapsed)
+	}
+}
+
+func TestSharedThrottle(t *testing.T) {
+	config := ThrottleConfig{RequestsPerSecond: 5, MaxConcurrentRequests: 3}
+
+	a := sharedThrottle("app.terraform.io", "token-a", config)
+	if b := sharedThrottle("app.terraform.io", "token-a", config); a != b {
+		t.Fatal("expected the same host and token to share a throttle")
+	}
+	if c := sharedThrottle("app.terraform.io", "token-b", config); a == c {
+		t.Fatal("expected different tokens to use different throttles")
+	}
+	if d := sharedThrottle("tfe.example.com", "token-a", config); a == d {
+		t.Fatal("expected different hosts to use different throttles")
+	}
+}
+
+func TestSharedThrottle_strictestLimits(t *testing.T) {
+	a := sharedThrottle("strict.example.com", "token-a", ThrottleConfig{RequestsPerSecond: 10})
+	b := sharedThrottle("strict.example.com", "token-a", ThrottleConfig{RequestsPerSecond: 2, MaxConcurrentRequests: 4})
+	c := sharedThrottle("strict.example.com", "token-a", ThrottleConfig{RequestsPerSecond: 5, MaxConcurrentRequests: 8})
+	if a != b || a != c {
+		t.Fatal("expected different limits for the same host and token to share a throttle")
+	}
+
+	if limit := a.limiter.Limit(); limit != 2 {
+		t.Fatalf("expected the lowest rate of 2 requests per second, got %v", limit)
+	}
+	if burst := a.limiter.Burst(); burst != 2 {
+		t.Fatalf("expected a burst of 2 requests, got %d", burst)
+	}
+	if a.maxConcurrent != 4 {
+		t.Fatalf("expected at most 4 requests in flight, got %d", a.maxConcurrent)
+	}
+}
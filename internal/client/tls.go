@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+)
+
+// TLSConfig holds the custom certificate authorities and client certificate
+// used to connect to HCP Terraform or Terraform Enterprise. Each PEM value can
+// be provided inline or as a path to a file.
+type TLSConfig struct {
+	CACertFile     string
+	CACertPEM      string
+	ClientCertFile string
+	ClientCertPEM  string
+	ClientKeyFile  string
+	ClientKeyPEM   string
+}
+
+// withEnvDefaults fills in the values that were not set in the provider
+// configuration from the environment. The environment is only consulted for a
+// setting when neither its file nor its inline value is configured.
+func (c TLSConfig) withEnvDefaults() TLSConfig {
+	pairs := []struct {
+		file, inline       *string
+		fileEnv, inlineEnv string
+	}{
+		{&c.CACertFile, &c.CACertPEM, "TFE_CA_CERT_FILE", "TFE_CA_CERT_PEM"},
+		{&c.ClientCertFile, &c.ClientCertPEM, "TFE_CLIENT_CERT_FILE", "TFE_CLIENT_CERT_PEM"},
+		{&c.ClientKeyFile, &c.ClientKeyPEM, "TFE_CLIENT_KEY_FILE", "TFE_CLIENT_KEY_PEM"},
+	}
+	for _, p := range pairs {
+		if *p.file == "" && *p.inline == "" {
+			*p.file = os.Getenv(p.fileEnv)
+			*p.inline = os.Getenv(p.inlineEnv)
+		}
+	}
+	return c
+}
+
+// String returns a stable representation of the TLS settings, suitable for
+// use in a client cache key. Certificate and key material is hashed.
+func (c TLSConfig) String() string {
+	h := sha256.New()
+	for _, v := range []string{c.CACertFile, c.CACertPEM, c.ClientCertFile, c.ClientCertPEM, c.ClientKeyFile, c.ClientKeyPEM} {
+		fmt.Fprintf(h, "%d:%s", len(v), v)
+	}
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
+
+// apply adds the configured certificate authorities and client certificate to
+// the given tls.Config.
+func (c TLSConfig) apply(config *tls.Config) error {
+	caPEM, err := pemValue("ca_cert_file", c.CACertFile, "ca_cert_pem", c.CACertPEM)
+	if err != nil {
+		return err
+	}
+	if caPEM != nil {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			log.Printf("[WARN] Unable to load the system certificate pool, only the configured CA bundle will be trusted: %v", err)
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return errors.New("the configured CA bundle does not contain any valid PEM encoded certificates")
+		}
+		config.RootCAs = pool
+		log.Printf("[DEBUG] Client configured with a custom CA bundle")
+	}
+
+	certPEM, err := pemValue("client_cert_file", c.ClientCertFile, "client_cert_pem", c.ClientCertPEM)
+	if err != nil {
+		return err
+	}
+	keyPEM, err := pemValue("client_key_file", c.ClientKeyFile, "client_key_pem", c.ClientKeyPEM)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case certPEM == nil && keyPEM == nil:
+		return nil
+	case certPEM == nil:
+		return errors.New("a client key was configured without a client certificate")
+	case keyPEM == nil:
+		return errors.New("a client certificate was configured without a client key")
+	}
+
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return fmt.Errorf("invalid client certificate or key: %w", err)
+	}
+	config.Certificates = []tls.Certificate{cert}
+	log.Printf("[DEBUG] Client configured with a client certificate")
+
+	return nil
+}
+
+// pemValue returns the PEM content from either the file or the inline value.
+// Setting both is an error.
+func pemValue(fileArg, file, pemArg, inline string) ([]byte, error) {
+	switch {
+	case file != "" && inline != "":
+		return nil, fmt.Errorf("only one of %s or %s can be set", fileArg, pemArg)
+	case file != "":
+		b, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", fileArg, err)
+		}
+		return b, nil
+	case inline != "":
+		return []byte(inline), nil
+	default:
+		return nil, nil
+	}
+}
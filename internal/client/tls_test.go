@@ -0,0 +1,162 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testClientCertificate generates a self-signed client certificate and key in
+// PEM format.
+func testClientCertificate(t *testing.T) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform-provider-tfe"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %v", err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return string(certPEM), string(keyPEM)
+}
+
+func TestTLSConfig_apply(t *testing.T) {
+	certPEM, keyPEM := testClientCertificate(t)
+
+	dir := t.TempDir()
+	caFile := filepath.Join(dir, "ca.pem")
+	if err := os.WriteFile(caFile, []byte(certPEM), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		config     TLSConfig
+		expectErr  string
+		expectCA   bool
+		expectCert bool
+	}{
+		"nothing configured": {},
+		"CA bundle from file": {
+			config:   TLSConfig{CACertFile: caFile},
+			expectCA: true,
+		},
+		"inline CA bundle": {
+			config:   TLSConfig{CACertPEM: certPEM},
+			expectCA: true,
+		},
+		"client certificate": {
+			config:     TLSConfig{ClientCertPEM: certPEM, ClientKeyPEM: keyPEM},
+			expectCert: true,
+		},
+		"invalid CA bundle": {
+			config:    TLSConfig{CACertPEM: "not a certificate"},
+			expectErr: "does not contain any valid PEM encoded certificates",
+		},
+		"missing CA file": {
+			config:    TLSConfig{CACertFile: filepath.Join(dir, "missing.pem")},
+			expectErr: "failed to read ca_cert_file",
+		},
+		"file and inline CA bundle": {
+			config:    TLSConfig{CACertFile: caFile, CACertPEM: certPEM},
+			expectErr: "only one of ca_cert_file or ca_cert_pem can be set",
+		},
+		"client certificate without key": {
+			config:    TLSConfig{ClientCertPEM: certPEM},
+			expectErr: "without a client key",
+		},
+		"client key without certificate": {
+			config:    TLSConfig{ClientKeyPEM: keyPEM},
+			expectErr: "without a client certificate",
+		},
+		"mismatched client certificate": {
+			config:    TLSConfig{ClientCertPEM: certPEM, ClientKeyPEM: certPEM},
+			expectErr: "invalid client certificate or key",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			config := &tls.Config{MinVersion: tls.VersionTLS12}
+			err := tc.config.apply(config)
+			if tc.expectErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.expectErr) {
+					t.Fatalf("expected error containing %q, got %v", tc.expectErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if (config.RootCAs != nil) != tc.expectCA {
+				t.Fatalf("expected custom root CAs: %t", tc.expectCA)
+			}
+			if (len(config.Certificates) == 1) != tc.expectCert {
+				t.Fatalf("expected client certificate: %t", tc.expectCert)
+			}
+		})
+	}
+}
+
+func TestTLSConfig_trustsCustomCA(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	t.Cleanup(srv.Close)
+
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+	if err := (TLSConfig{CACertPEM: string(caPEM)}).apply(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	httpClient := &http.Client{Transport: &http.Transport{TLSClientConfig: config}}
+	resp, err := httpClient.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("expected the custom CA to be trusted, got %v", err)
+	}
+	resp.Body.Close()
+}
+
+func TestTLSConfig_withEnvDefaults(t *testing.T) {
+	t.Setenv("TFE_CA_CERT_FILE", "/env/ca.pem")
+	t.Setenv("TFE_CLIENT_CERT_PEM", "env-cert")
+
+	config := TLSConfig{ClientCertFile: "/config/cert.pem"}.withEnvDefaults()
+	if config.CACertFile != "/env/ca.pem" {
+		t.Fatalf("expected CA file from env, got %q", config.CACertFile)
+	}
+	if config.ClientCertFile != "/config/cert.pem" || config.ClientCertPEM != "" {
+		t.Fatalf("expected provider configuration to take precedence over env, got %+v", config)
+	}
+}
@@ -0,0 +1,405 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package logging
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+const (
+	// EnvCassette is the path of the cassette file used to record or replay
+	// API traffic.
+	EnvCassette = "TFE_CASSETTE"
+
+	// EnvCassetteMode selects whether API traffic is recorded to or replayed
+	// from the cassette. It must be CassetteModeRecord or CassetteModeReplay.
+	EnvCassetteMode = "TFE_CASSETTE_MODE"
+
+	CassetteModeRecord = "record"
+	CassetteModeReplay = "replay"
+
+	// redacted replaces sensitive values in recorded interactions.
+	redacted = "REDACTED"
+)
+
+// redactedCassetteHeaders are the headers whose values are never written to a
+// cassette.
+var redactedCassetteHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// secretAttributePattern matches the JSON attributes holding secret values,
+// such as the token returned when a team token is created, the private key
+// of an SSH key or of a VCS connection, and the signed URLs of uploads, logs
+// and hosted state, such as upload-url or log-read-url.
+var secretAttributePattern = regexp.MustCompile(`("(?:token|oauth-token-string|private-key|secret|ssh-key|password|[a-z-]*-url)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+// sensitiveEndpointPattern matches the paths of the endpoints whose bodies
+// hold state or plan content, such as state versions and their outputs, JSON
+// plans, and the hosted state and plan files they link to. Their bodies are
+// left out of the cassette entirely.
+var sensitiveEndpointPattern = regexp.MustCompile(`/(?:state-versions|current-state-version|state-version-outputs|current-state-version-outputs|json-output|json-output-redacted|_archivist)(?:/|$)`)
+
+// archivistPathPattern matches the paths of the hosted files, whose signed
+// URLs carry their credentials in the query string.
+var archivistPathPattern = regexp.MustCompile(`/_archivist(?:/|$)`)
+
+// Cassette is a file of recorded API interactions, holding one JSON encoded
+// Interaction per line.
+type Cassette struct {
+	Interactions []*Interaction
+
+	path string
+	mu   sync.Mutex
+
+	// served counts the interactions already replayed for each request key.
+	served map[string]int
+}
+
+// Interaction is a single recorded request and its response.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is the sanitized request of an interaction.
+type RecordedRequest struct {
+	Method       string      `json:"method"`
+	URL          string      `json:"url"`
+	Header       http.Header `json:"header,omitempty"`
+	Body         string      `json:"body,omitempty"`
+	BodyEncoding string      `json:"body_encoding,omitempty"`
+}
+
+// RecordedResponse is the sanitized response of an interaction.
+type RecordedResponse struct {
+	StatusCode   int         `json:"status_code"`
+	Header       http.Header `json:"header,omitempty"`
+	Body         string      `json:"body,omitempty"`
+	BodyEncoding string      `json:"body_encoding,omitempty"`
+}
+
+var (
+	cassettesMu sync.Mutex
+	cassettes   = make(map[string]*Cassette)
+)
+
+// openCassette returns the cassette for the given path, loading it on first
+// use. Every transport of the provider process shares the same cassette, so
+// that interactions are recorded and replayed in order. When recording, new
+// interactions are appended to the file, as Terraform starts a new provider
+// process for each of validate, plan and apply.
+func openCassette(path, mode string) (*Cassette, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", EnvCassette, err)
+	}
+
+	cassettesMu.Lock()
+	defer cassettesMu.Unlock()
+
+	key := mode + " " + abs
+	if c, ok := cassettes[key]; ok {
+		return c, nil
+	}
+
+	c := &Cassette{path: abs, served: make(map[string]int)}
+	if mode == CassetteModeRecord {
+		// Only make sure the cassette can be written to, recorded interactions
+		// are never read back.
+		f, err := c.openForAppend()
+		if err != nil {
+			return nil, err
+		}
+		f.Close()
+	} else if err := c.load(); err != nil {
+		return nil, err
+	}
+
+	cassettes[key] = c
+	return c, nil
+}
+
+// load reads the interactions of the cassette file.
+func (c *Cassette) load() error {
+	f, err := os.Open(c.path)
+	if err != nil {
+		return fmt.Errorf("failed to read cassette: %w", err)
+	}
+	defer f.Close()
+
+	dec := json.NewDecoder(f)
+	for {
+		var i Interaction
+		err := dec.Decode(&i)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to parse cassette %s: %w", c.path, err)
+		}
+		c.Interactions = append(c.Interactions, &i)
+	}
+}
+
+// record appends the interaction to the cassette file as soon as it happens,
+// so that nothing is lost when the provider process is stopped. Each
+// interaction is written with a single append, so that provider processes
+// recording to the same cassette do not overwrite each other.
+func (c *Cassette) record(i *Interaction) error {
+	b, err := json.Marshal(i)
+	if err != nil {
+		return fmt.Errorf("failed to encode interaction: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	f, err := c.openForAppend()
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(b, '\n')); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write cassette: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write cassette: %w", err)
+	}
+
+	c.Interactions = append(c.Interactions, i)
+	return nil
+}
+
+func (c *Cassette) openForAppend() (*os.File, error) {
+	f, err := os.OpenFile(c.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open cassette: %w", err)
+	}
+	return f, nil
+}
+
+// replay returns the next recorded response for the request. Requests are
+// matched by method, path and query, in the order they were recorded. Once
+// every matching interaction has been served, the last one is served again.
+func (c *Cassette) replay(req *http.Request) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := requestKey(req.Method, recordedURL(req.URL).RequestURI())
+
+	var matches []*Interaction
+	for _, i := range c.Interactions {
+		u, err := parseRequestURI(i.Request.URL)
+		if err != nil {
+			continue
+		}
+		if requestKey(i.Request.Method, u) == key {
+			matches = append(matches, i)
+		}
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("no interaction recorded for %s %s in cassette %s", req.Method, recordedURL(req.URL).RequestURI(), c.path)
+	}
+
+	n := c.served[key]
+	if n >= len(matches) {
+		n = len(matches) - 1
+	}
+	c.served[key]++
+
+	recorded := matches[n].Response
+	body, err := decodeBody(recorded.Body, recorded.BodyEncoding)
+	if err != nil {
+		return nil, fmt.Errorf("invalid response body in cassette %s: %w", c.path, err)
+	}
+
+	header := recorded.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+func requestKey(method, requestURI string) string {
+	return method + " " + requestURI
+}
+
+// recordedURL returns the URL as stored in a cassette. The query string of
+// hosted file URLs is left out, as it holds their signature.
+func recordedURL(u *url.URL) *url.URL {
+	if !archivistPathPattern.MatchString(u.Path) {
+		return u
+	}
+
+	stripped := *u
+	stripped.RawQuery = ""
+	stripped.ForceQuery = false
+	return &stripped
+}
+
+// parseRequestURI returns the path and query of a recorded URL.
+func parseRequestURI(rawURL string) (string, error) {
+	req, err := http.NewRequest(http.MethodGet, rawURL, nil)
+	if err != nil {
+		return "", err
+	}
+	return req.URL.RequestURI(), nil
+}
+
+// cassetteTransport records the API traffic to a cassette, or serves it from
+// a cassette without sending any request.
+type cassetteTransport struct {
+	cassette *Cassette
+	mode     string
+	delegate http.RoundTripper
+}
+
+// NewCassetteTransport wraps the given transport so that API traffic is
+// recorded to or replayed from the cassette configured with the TFE_CASSETTE
+// and TFE_CASSETTE_MODE environment variables. The transport is returned
+// unchanged when no cassette is configured.
+func NewCassetteTransport(t http.RoundTripper) (http.RoundTripper, error) {
+	path := os.Getenv(EnvCassette)
+	mode := strings.ToLower(os.Getenv(EnvCassetteMode))
+	if path == "" && mode == "" {
+		return t, nil
+	}
+
+	switch {
+	case path == "":
+		return nil, fmt.Errorf("%s must be set when %s is set", EnvCassette, EnvCassetteMode)
+	case mode != CassetteModeRecord && mode != CassetteModeReplay:
+		return nil, fmt.Errorf("%s must be %q or %q, got %q", EnvCassetteMode, CassetteModeRecord, CassetteModeReplay, mode)
+	}
+
+	cassette, err := openCassette(path, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[DEBUG] Using cassette %s in %s mode", Sanitize(cassette.path), mode) // nolint:gosec
+	return &cassetteTransport{cassette: cassette, mode: mode, delegate: t}, nil
+}
+
+func (t *cassetteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.mode == CassetteModeReplay {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return t.cassette.replay(req)
+	}
+
+	// Checking for sensitive values also buffers the request body.
+	sensitive := hasSensitiveValues(req) || sensitiveEndpointPattern.MatchString(req.URL.Path)
+
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		reqBody, err = io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	resp, err := t.delegate.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	interaction := &Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			URL:    recordedURL(req.URL).String(),
+			Header: redactHeaders(req.Header),
+		},
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     redactHeaders(resp.Header),
+		},
+	}
+	interaction.Request.Body, interaction.Request.BodyEncoding = encodeBody(reqBody, sensitive)
+	interaction.Response.Body, interaction.Response.BodyEncoding = encodeBody(respBody, sensitive)
+
+	if err := t.cassette.record(interaction); err != nil {
+		// Failing to record must not change the outcome of the request.
+		log.Printf("[ERROR] Failed to record %s %s: %s", req.Method, Sanitize(req.URL.Path), Sanitize(err.Error())) // nolint:gosec
+	}
+
+	return resp, nil
+}
+
+// redactHeaders returns a copy of the headers without credentials.
+func redactHeaders(h http.Header) http.Header {
+	if len(h) == 0 {
+		return nil
+	}
+
+	h = h.Clone()
+	for _, name := range redactedCassetteHeaders {
+		if h.Get(name) != "" {
+			h.Set(name, redacted)
+		}
+	}
+	return h
+}
+
+// encodeBody returns the body as stored in a cassette. Bodies of requests with
+// sensitive values or to sensitive endpoints are left out entirely, and secret
+// attributes are redacted from all other bodies. Binary bodies are base64
+// encoded.
+func encodeBody(b []byte, sensitive bool) (string, string) {
+	switch {
+	case len(b) == 0:
+		return "", ""
+	case sensitive:
+		return "[BODY REDACTED: Due to sensitive values present]", ""
+	case !utf8.Valid(b):
+		return base64.StdEncoding.EncodeToString(b), "base64"
+	default:
+		return secretAttributePattern.ReplaceAllString(string(b), `${1}"`+redacted+`"`), ""
+	}
+}
+
+func decodeBody(body, encoding string) ([]byte, error) {
+	switch encoding {
+	case "":
+		return []byte(body), nil
+	case "base64":
+		return base64.StdEncoding.DecodeString(body)
+	default:
+		return nil, errors.New("unknown body encoding " + encoding)
+	}
+}
@@ -0,0 +1,317 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package logging
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestNewCassetteTransport_disabled(t *testing.T) {
+	t.Setenv(EnvCassette, "")
+	t.Setenv(EnvCassetteMode, "")
+
+	delegate := &http.Transport{}
+	transport, err := NewCassetteTransport(delegate)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if transport != delegate {
+		t.Fatal("expected the transport to be returned unchanged")
+	}
+}
+
+func TestNewCassetteTransport_invalid(t *testing.T) {
+	cases := map[string]struct {
+		path, mode, err string
+	}{
+		"unknown mode": {
+			path: filepath.Join(t.TempDir(), "cassette.json"),
+			mode: "rewind",
+			err:  `TFE_CASSETTE_MODE must be "record" or "replay", got "rewind"`,
+		},
+		"missing path": {
+			mode: CassetteModeRecord,
+			err:  "TFE_CASSETTE must be set when TFE_CASSETTE_MODE is set",
+		},
+		"missing cassette": {
+			path: filepath.Join(t.TempDir(), "missing.json"),
+			mode: CassetteModeReplay,
+			err:  "failed to read cassette",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(EnvCassette, tc.path)
+			t.Setenv(EnvCassetteMode, tc.mode)
+
+			_, err := NewCassetteTransport(&http.Transport{})
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("expected error containing %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestCassetteTransport_recordAndReplay(t *testing.T) {
+	var runs int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		w.Header().Set("Set-Cookie", "session=secret-cookie")
+		switch r.URL.Path {
+		case "/api/v2/runs/run-1":
+			runs++
+			if runs == 1 {
+				io.WriteString(w, `{"data":{"attributes":{"status":"planning"}}}`)
+			} else {
+				io.WriteString(w, `{"data":{"attributes":{"status":"applied"}}}`)
+			}
+		case "/api/v2/teams/team-1/authentication-token":
+			w.WriteHeader(http.StatusCreated)
+			io.WriteString(w, `{"data":{"attributes":{"token":"secret-token"}}}`)
+		case "/api/v2/vars":
+			io.WriteString(w, `{"data":{"attributes":{"value":"secret-value","sensitive":true}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	t.Setenv(EnvCassette, path)
+
+	requests := []struct {
+		method, path, body string
+	}{
+		{http.MethodGet, "/api/v2/runs/run-1", ""},
+		{http.MethodGet, "/api/v2/runs/run-1", ""},
+		{http.MethodPost, "/api/v2/teams/team-1/authentication-token", ""},
+		{http.MethodPost, "/api/v2/vars", `{"data":{"attributes":{"value":"secret-value","sensitive":true}}}`},
+	}
+
+	do := func(t *testing.T, transport http.RoundTripper, method, path, body string) (int, string) {
+		t.Helper()
+
+		var reqBody io.Reader
+		if body != "" {
+			reqBody = strings.NewReader(body)
+		}
+		req, err := http.NewRequest(method, server.URL+path, reqBody)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Authorization", "Bearer secret-auth")
+
+		resp, err := transport.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("unexpected error for %s %s: %s", method, path, err)
+		}
+		defer resp.Body.Close()
+
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.StatusCode, string(b)
+	}
+
+	t.Setenv(EnvCassetteMode, CassetteModeRecord)
+	recorder, err := NewCassetteTransport(http.DefaultTransport)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var recorded []string
+	for _, r := range requests {
+		status, body := do(t, recorder, r.method, r.path, r.body)
+		recorded = append(recorded, body)
+		if r.path == "/api/v2/teams/team-1/authentication-token" && (status != http.StatusCreated || !strings.Contains(body, "secret-token")) {
+			t.Fatalf("expected the live response to be passed through unchanged, got %d %s", status, body)
+		}
+	}
+
+	server.Close()
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"secret-auth", "secret-cookie", "secret-token", "secret-value"} {
+		if strings.Contains(string(b), secret) {
+			t.Errorf("expected %q to be redacted from the cassette:\n%s", secret, b)
+		}
+	}
+
+	t.Setenv(EnvCassetteMode, CassetteModeReplay)
+	player, err := NewCassetteTransport(http.DefaultTransport)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The server is closed, so every response must come from the cassette.
+	if _, body := do(t, player, http.MethodGet, "/api/v2/runs/run-1", ""); body != recorded[0] {
+		t.Errorf("expected the first recorded response %s, got %s", recorded[0], body)
+	}
+	if _, body := do(t, player, http.MethodGet, "/api/v2/runs/run-1", ""); body != recorded[1] {
+		t.Errorf("expected the second recorded response %s, got %s", recorded[1], body)
+	}
+	if _, body := do(t, player, http.MethodGet, "/api/v2/runs/run-1", ""); body != recorded[1] {
+		t.Errorf("expected the last recorded response to be repeated, got %s", body)
+	}
+
+	status, body := do(t, player, http.MethodPost, "/api/v2/teams/team-1/authentication-token", "")
+	if status != http.StatusCreated {
+		t.Errorf("expected status %d, got %d", http.StatusCreated, status)
+	}
+	if body != `{"data":{"attributes":{"token":"REDACTED"}}}` {
+		t.Errorf("expected the token to be redacted, got %s", body)
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, server.URL+"/api/v2/runs/run-1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = player.RoundTrip(req)
+	if err == nil || !strings.Contains(err.Error(), "no interaction recorded for DELETE /api/v2/runs/run-1") {
+		t.Fatalf("expected a missing interaction error, got %v", err)
+	}
+}
+
+func TestCassetteTransport_redactsSecrets(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/workspaces/ws-1/current-state-version-outputs":
+			io.WriteString(w, `{"data":[{"attributes":{"name":"password","value":"secret-output","sensitive":false}}]}`)
+		case "/api/v2/state-versions/sv-1":
+			io.WriteString(w, `{"data":{"attributes":{"hosted-state-download-url":"https://archivist.example.com/secret-url"}}}`)
+		case "/api/v2/configuration-versions/cv-1":
+			io.WriteString(w, `{"data":{"attributes":{"status":"pending","upload-url":"https://archivist.example.com/v1/object/secret-upload"}}}`)
+		case "/api/v2/plans/plan-1":
+			io.WriteString(w, `{"data":{"attributes":{"status":"finished","log-read-url":"https://archivist.example.com/v1/object/secret-log"}}}`)
+		case "/_archivist/v1/object/obj-1":
+			io.WriteString(w, `plan log`)
+		case "/api/v2/plans/plan-1/json-output":
+			io.WriteString(w, `{"planned_values":{"root_module":{"resources":[{"values":{"password":"secret-plan"}}]}}}`)
+		default:
+			io.WriteString(w, `{"data":{"id":"ot-1"}}`)
+		}
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	t.Setenv(EnvCassette, path)
+	t.Setenv(EnvCassetteMode, CassetteModeRecord)
+	recorder, err := NewCassetteTransport(http.DefaultTransport)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	requests := []struct {
+		method, path, body string
+	}{
+		{http.MethodGet, "/api/v2/workspaces/ws-1/current-state-version-outputs", ""},
+		{http.MethodGet, "/api/v2/state-versions/sv-1", ""},
+		{http.MethodGet, "/api/v2/plans/plan-1/json-output", ""},
+		{http.MethodGet, "/api/v2/configuration-versions/cv-1", ""},
+		{http.MethodGet, "/api/v2/plans/plan-1", ""},
+		{http.MethodGet, "/_archivist/v1/object/obj-1?signature=secret-signature", ""},
+		{http.MethodPost, "/api/v2/organizations/org/oauth-clients", `{"data":{"attributes":{"oauth-token-string":"secret-oauth","private-key":"secret-key","secret":"secret-webhook"}}}`},
+		{http.MethodPost, "/api/v2/organizations/org/ssh-keys", `{"data":{"attributes":{"name":"deploy","value":"x","ssh-key":"secret-ssh"}}}`},
+	}
+	for _, r := range requests {
+		var body io.Reader
+		if r.body != "" {
+			body = strings.NewReader(r.body)
+		}
+		req, err := http.NewRequest(r.method, server.URL+r.path, body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := recorder.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("unexpected error for %s %s: %s", r.method, r.path, err)
+		}
+		resp.Body.Close()
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"secret-output", "secret-url", "secret-plan", "secret-upload", "secret-log", "secret-signature", "secret-oauth", "secret-key", "secret-webhook", "secret-ssh"} {
+		if strings.Contains(string(b), secret) {
+			t.Errorf("expected %q to be redacted from the cassette:\n%s", secret, b)
+		}
+	}
+	for _, want := range []string{"ot-1", `\"upload-url\":\"REDACTED\"`, `"url":"` + server.URL + `/_archivist/v1/object/obj-1"`} {
+		if !strings.Contains(string(b), want) {
+			t.Errorf("expected %s to be recorded:\n%s", want, b)
+		}
+	}
+
+	t.Setenv(EnvCassetteMode, CassetteModeReplay)
+	player, err := NewCassetteTransport(http.DefaultTransport)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// A new signature must still match the hosted file recorded without one.
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/_archivist/v1/object/obj-1?signature=other-signature", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := player.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+}
+
+func TestOpenCassette_recordAppends(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	for _, runID := range []string{"run-1", "run-2"} {
+		// Forget the cassette, as a new provider process would.
+		cassettesMu.Lock()
+		cassettes = make(map[string]*Cassette)
+		cassettesMu.Unlock()
+
+		c, err := openCassette(path, CassetteModeRecord)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		err = c.record(&Interaction{
+			Request:  RecordedRequest{Method: http.MethodGet, URL: "https://app.terraform.io/api/v2/runs/" + runID},
+			Response: RecordedResponse{StatusCode: http.StatusOK},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lines := strings.Count(string(b), "\n"); lines != 2 {
+		t.Fatalf("expected one line per interaction, got %d:\n%s", lines, b)
+	}
+
+	cassettesMu.Lock()
+	cassettes = make(map[string]*Cassette)
+	cassettesMu.Unlock()
+
+	c, err := openCassette(path, CassetteModeReplay)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(c.Interactions) != 2 || !strings.HasSuffix(c.Interactions[1].Request.URL, "/run-2") {
+		t.Fatalf("expected the interactions of both processes, got %v", c.Interactions)
+	}
+}
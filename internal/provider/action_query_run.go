@@ -174,7 +174,7 @@ func (a *actionTFEQueryRun) Invoke(ctx context.Context, req action.InvokeRequest
 	for {
 		select {
 		case <-ctx.Done():
-			resp.Diagnostics.AddError("Context cancelled", "Context cancelled while waiting for query run.")
+			resp.Diagnostics.AddError("Operation cancelled", fmt.Sprintf("Operation cancelled, query run %s left in state %s.", run.ID, lastStatus))
 			return
 		case <-pollTicker.C:
 			// Refresh run status
@@ -0,0 +1,233 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ action.Action              = &actionTFERun{}
+	_ action.ActionWithConfigure = &actionTFERun{}
+)
+
+const (
+	runOperationPlan    = "plan"
+	runOperationApply   = "apply"
+	runOperationDestroy = "destroy"
+)
+
+// runActionPendingStatuses are the statuses of a run that has not finished
+// yet. Apply and destroy runs are created with auto-apply, so they do not stop
+// to wait for a confirmation.
+var runActionPendingStatuses = map[tfe.RunStatus]bool{
+	tfe.RunPending:            true,
+	tfe.RunFetching:           true,
+	tfe.RunFetchingCompleted:  true,
+	tfe.RunPrePlanRunning:     true,
+	tfe.RunPrePlanCompleted:   true,
+	tfe.RunQueuing:            true,
+	tfe.RunPlanQueued:         true,
+	tfe.RunPlanning:           true,
+	tfe.RunPlanned:            true,
+	tfe.RunCostEstimating:     true,
+	tfe.RunCostEstimated:      true,
+	tfe.RunPolicyChecking:     true,
+	tfe.RunPolicyChecked:      true,
+	tfe.RunPostPlanRunning:    true,
+	tfe.RunPostPlanCompleted:  true,
+	tfe.RunConfirmed:          true,
+	tfe.RunQueuingApply:       true,
+	tfe.RunApplyQueued:        true,
+	tfe.RunApplying:           true,
+	tfe.RunPreApplyRunning:    true,
+	tfe.RunPreApplyCompleted:  true,
+	tfe.RunPostApplyRunning:   true,
+	tfe.RunPostApplyCompleted: true,
+}
+
+// runActionDoneStatuses are the statuses the action stops waiting at. Runs
+// waiting for a policy override are done too, as an action cannot wait for
+// someone to override them.
+var runActionDoneStatuses = map[tfe.RunStatus]bool{
+	tfe.RunApplied:                  true,
+	tfe.RunPlannedAndFinished:       true,
+	tfe.RunErrored:                  true,
+	tfe.RunCanceled:                 true,
+	tfe.RunDiscarded:                true,
+	tfe.RunPolicySoftFailed:         true,
+	tfe.RunPolicyOverride:           true,
+	tfe.RunPostPlanAwaitingDecision: true,
+}
+
+func NewRunAction() action.Action {
+	return &actionTFERun{}
+}
+
+type actionTFERun struct {
+	config ConfiguredClient
+}
+
+type actionTFERunModel struct {
+	ConfigurationVersionID types.String `tfsdk:"configuration_version_id"`
+	Message                types.String `tfsdk:"message"`
+	Operation              types.String `tfsdk:"operation"`
+	WorkspaceID            types.String `tfsdk:"workspace_id"`
+}
+
+func (a *actionTFERun) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ConfiguredClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected action Configure type",
+			fmt.Sprintf("Expected tfe.ConfiguredClient, got %T. This is a bug in the tfe provider, so please report it on Github.", req.ProviderData),
+		)
+	}
+	a.config = client
+}
+
+func (a *actionTFERun) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_run"
+}
+
+func (a *actionTFERun) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a plan, apply or destroy run in an HCP Terraform or Terraform Enterprise workspace and waits for it to finish." +
+			"\n\nThe action reports the status transitions of the run and its position in the queue while it waits. It fails when the run errors, is canceled or discarded, or fails its policy checks. Apply and destroy runs are applied automatically, regardless of the auto-apply setting of the workspace.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": schema.StringAttribute{
+				Description: "The ID of the workspace where the run will be executed.",
+				Required:    true,
+			},
+			"operation": schema.StringAttribute{
+				Description: "The operation of the run, one of `plan`, `apply` or `destroy`. A `plan` run is a speculative plan that cannot be applied. Defaults to `apply`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(runOperationPlan, runOperationApply, runOperationDestroy),
+				},
+			},
+			"configuration_version_id": schema.StringAttribute{
+				Description: "A specific Configuration Version ID to use for the run (e.g., \"cv-ntv3HbhJqvFzamy7\"). Defaults to the latest configuration version of the workspace.",
+				Optional:    true,
+			},
+			"message": schema.StringAttribute{
+				Description: "The message of the run. Defaults to \"Triggered by the tfe_run action\".",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *actionTFERun) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data actionTFERunModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := a.config.Client
+	workspaceID := data.WorkspaceID.ValueString()
+	operation := data.Operation.ValueString()
+	if operation == "" {
+		operation = runOperationApply
+	}
+
+	ws, err := client.Workspaces.ReadByID(ctx, workspaceID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading workspace", fmt.Sprintf("Error reading workspace %s: %s", workspaceID, err))
+		return
+	}
+
+	message := "Triggered by the tfe_run action"
+	if !data.Message.IsNull() {
+		message = data.Message.ValueString()
+	}
+
+	createOpts := tfe.RunCreateOptions{
+		Workspace: ws,
+		Message:   tfe.String(message),
+		IsDestroy: tfe.Bool(operation == runOperationDestroy),
+	}
+	if operation == runOperationPlan {
+		createOpts.PlanOnly = tfe.Bool(true)
+	} else {
+		createOpts.AutoApply = tfe.Bool(true)
+	}
+	if !data.ConfigurationVersionID.IsNull() {
+		createOpts.ConfigurationVersion = &tfe.ConfigurationVersion{
+			ID: data.ConfigurationVersionID.ValueString(),
+		}
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Creating %s run...", operation)})
+
+	run, err := client.Runs.Create(ctx, createOpts)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating run", fmt.Sprintf("Error creating %s run in workspace %s: %s", operation, workspaceID, err))
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Run %s created. Status: %s", run.ID, run.Status),
+	})
+
+	progress := func(message string) {
+		resp.SendProgress(action.InvokeProgressEvent{Message: message})
+	}
+	isPlanOp := operation == runOperationPlan
+	run, err = awaitRunWithProgress(ctx, client, run, ws.Organization.Name, isPlanOp, runActionPendingStatuses, isRunActionDone, progress)
+	if err != nil {
+		resp.Diagnostics.AddError("Error waiting for run", err.Error())
+		return
+	}
+
+	result, err := readRunWithResult(ctx, client, run.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading run", fmt.Sprintf("Error reading run %s: %s", run.ID, err))
+		return
+	}
+	htmlURL := runHTMLURL(client, result)
+
+	switch run.Status {
+	case tfe.RunApplied, tfe.RunPlannedAndFinished:
+		counts := newRunResult(result)
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Run %s finished with status %s: %d to add, %d to change, %d to destroy, %d to import.",
+				run.ID, run.Status, counts.additions, counts.changes, counts.destructions, counts.imports),
+		})
+	case tfe.RunErrored:
+		resp.Diagnostics.AddError(
+			"Run errored",
+			fmt.Sprintf("Run %s finished with an error, view the run on %s for details", run.ID, htmlURL),
+		)
+	case tfe.RunCanceled, tfe.RunDiscarded:
+		resp.Diagnostics.AddError(
+			"Run canceled",
+			fmt.Sprintf("Run %s was %s before it finished, view the run on %s for details", run.ID, run.Status, htmlURL),
+		)
+	default:
+		resp.Diagnostics.AddError(
+			"Run failed policy checks",
+			fmt.Sprintf("Run %s is waiting for its failed policies to be overridden, status is %s. View the run on %s for details", run.ID, run.Status, htmlURL),
+		)
+	}
+}
+
+func isRunActionDone(run *tfe.Run) bool {
+	_, found := runActionDoneStatuses[run.Status]
+	return found
+}
@@ -0,0 +1,216 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"regexp"
+	"testing"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccTFERunAction_apply(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	organization, orgCleanup := createOrganization(t, tfeClient, tfe.OrganizationCreateOptions{
+		Name:  tfe.String(fmt.Sprintf("tst-org-%d", rInt)),
+		Email: tfe.String("admin@terraformer.inc"),
+	})
+	t.Cleanup(orgCleanup)
+
+	parentWorkspace, _ := setupWorkspacesWithConfig(t, tfeClient, rInt, organization.Name, "test-fixtures/basic-config")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccMuxedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFERunAction_operation(parentWorkspace.ID, "apply"),
+				PostApplyFunc: func() {
+					checkTFERunActionRun(t, tfeClient, parentWorkspace.ID, tfe.RunApplied, false)
+				},
+			},
+		},
+	})
+}
+
+func TestAccTFERunAction_planWithConfigVersion(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	organization, orgCleanup := createOrganization(t, tfeClient, tfe.OrganizationCreateOptions{
+		Name:  tfe.String(fmt.Sprintf("tst-org-%d", rInt)),
+		Email: tfe.String("admin@terraformer.inc"),
+	})
+	t.Cleanup(orgCleanup)
+
+	parentWorkspace, _ := setupWorkspacesWithConfig(t, tfeClient, rInt, organization.Name, "test-fixtures/basic-config")
+	cvID := getLatestConfigurationVersionID(t, tfeClient, parentWorkspace.ID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccMuxedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFERunAction_planWithConfigVersion(parentWorkspace.ID, cvID),
+				PostApplyFunc: func() {
+					checkTFERunActionRun(t, tfeClient, parentWorkspace.ID, tfe.RunPlannedAndFinished, false)
+				},
+			},
+		},
+	})
+}
+
+func TestAccTFERunAction_destroy(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	organization, orgCleanup := createOrganization(t, tfeClient, tfe.OrganizationCreateOptions{
+		Name:  tfe.String(fmt.Sprintf("tst-org-%d", rInt)),
+		Email: tfe.String("admin@terraformer.inc"),
+	})
+	t.Cleanup(orgCleanup)
+
+	parentWorkspace, _ := setupWorkspacesWithConfig(t, tfeClient, rInt, organization.Name, "test-fixtures/basic-config")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccMuxedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFERunAction_operation(parentWorkspace.ID, "destroy"),
+				PostApplyFunc: func() {
+					checkTFERunActionRun(t, tfeClient, parentWorkspace.ID, tfe.RunApplied, true)
+				},
+			},
+		},
+	})
+}
+
+func TestAccTFERunAction_errored(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	organization, orgCleanup := createOrganization(t, tfeClient, tfe.OrganizationCreateOptions{
+		Name:  tfe.String(fmt.Sprintf("tst-org-%d", rInt)),
+		Email: tfe.String("admin@terraformer.inc"),
+	})
+	t.Cleanup(orgCleanup)
+
+	parentWorkspace, _ := setupWorkspacesWithConfig(t, tfeClient, rInt, organization.Name, "test-fixtures/config-with-error-during-plan")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccMuxedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTFERunAction_operation(parentWorkspace.ID, "apply"),
+				ExpectError: regexp.MustCompile(`Run errored`),
+			},
+		},
+	})
+}
+
+func checkTFERunActionRun(t *testing.T, client *tfe.Client, workspaceID string, status tfe.RunStatus, isDestroy bool) {
+	runs, err := client.Runs.List(context.Background(), workspaceID, &tfe.RunListOptions{
+		ListOptions: tfe.ListOptions{PageSize: 1},
+	})
+	if err != nil {
+		t.Fatalf("Error listing runs: %s", err)
+	}
+	if len(runs.Items) == 0 {
+		t.Fatalf("No runs found in workspace %s", workspaceID)
+	}
+
+	run := runs.Items[0]
+	if run.Status != status {
+		t.Fatalf("Expected run %s to be %s, got %s", run.ID, status, run.Status)
+	}
+	if run.IsDestroy != isDestroy {
+		t.Fatalf("Expected run %s to have is_destroy %t, got %t", run.ID, isDestroy, run.IsDestroy)
+	}
+}
+
+func testAccTFERunAction_operation(workspaceID, operation string) string {
+	return fmt.Sprintf(`
+resource "terraform_data" "test" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.tfe_run.test]
+    }
+  }
+}
+
+action "tfe_run" "test" {
+  config {
+    workspace_id = "%s"
+    operation    = "%s"
+  }
+}`, workspaceID, operation)
+}
+
+func testAccTFERunAction_planWithConfigVersion(workspaceID, cvID string) string {
+	return fmt.Sprintf(`
+resource "terraform_data" "test" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.tfe_run.test]
+    }
+  }
+}
+
+action "tfe_run" "test" {
+  config {
+    workspace_id             = "%s"
+    operation                = "plan"
+    configuration_version_id = "%s"
+    message                  = "Planned by the tfe_run action test"
+  }
+}`, workspaceID, cvID)
+}
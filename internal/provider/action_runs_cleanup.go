@@ -0,0 +1,241 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework-validators/actionvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-tfe/internal/provider/validators"
+)
+
+var (
+	_ action.Action                     = &actionTFERunsCleanup{}
+	_ action.ActionWithConfigure        = &actionTFERunsCleanup{}
+	_ action.ActionWithConfigValidators = &actionTFERunsCleanup{}
+)
+
+func NewRunsCleanupAction() action.Action {
+	return &actionTFERunsCleanup{}
+}
+
+type actionTFERunsCleanup struct {
+	config ConfiguredClient
+}
+
+type actionTFERunsCleanupModel struct {
+	modelWorkspaceSelector
+	ProjectID types.String `tfsdk:"project_id"`
+	Operation types.String `tfsdk:"operation"`
+	Statuses  types.Set    `tfsdk:"statuses"`
+	OlderThan types.String `tfsdk:"older_than"`
+	Comment   types.String `tfsdk:"comment"`
+	DryRun    types.Bool   `tfsdk:"dry_run"`
+}
+
+func (a *actionTFERunsCleanup) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ConfiguredClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected action Configure type",
+			fmt.Sprintf("Expected tfe.ConfiguredClient, got %T. This is a bug in the tfe provider, so please report it on Github.", req.ProviderData),
+		)
+	}
+	a.config = client
+}
+
+func (a *actionTFERunsCleanup) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_runs_cleanup"
+}
+
+func (a *actionTFERunsCleanup) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	attributes := workspaceSelectorAttributes()
+	maps.Copy(attributes, map[string]schema.Attribute{
+		"workspace_ids": schema.SetAttribute{
+			Description: "The IDs of the workspaces. Conflicts with `project_id`, `names` and `tag_filters`.",
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"project_id": schema.StringAttribute{
+			Description: "The ID of the project whose workspaces to search for. When set with `names` or `tag_filters`, the workspaces must match them too.",
+			Optional:    true,
+		},
+		"organization": schema.StringAttribute{
+			Description: "The name of the organization to search for workspaces with `names` and `tag_filters`. Defaults to the organization of `project_id`, or else to the provider organization.",
+			Optional:    true,
+		},
+		"operation": schema.StringAttribute{
+			Description: "What to do with the selected runs, one of `discard`, `cancel` or `force_cancel`. Runs the operation does not apply to in their current status, such as pending runs that cannot be force canceled, are skipped.",
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(runCleanupDiscard, runCleanupCancel, runCleanupForceCancel),
+			},
+		},
+		"statuses": schema.SetAttribute{
+			Description: "The statuses of the runs to select, such as `pending` or `planned`. Defaults to the runs waiting to start or to be confirmed: `pending`, `planned`, `cost_estimated`, `policy_checked`, `policy_override` and `post_plan_completed`.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+				setvalidator.ValueStringsAre(stringvalidator.OneOf(runStatusValues()...)),
+			},
+		},
+		"older_than": schema.StringAttribute{
+			Description: "Only select the runs created longer ago than this duration, such as `2h`. Defaults to runs of any age.",
+			Optional:    true,
+			Validators: []validator.String{
+				validators.IsDuration(),
+			},
+		},
+		"comment": schema.StringAttribute{
+			Description: "The comment explaining why the runs were discarded or canceled. Defaults to \"Cleaned up by the tfe_runs_cleanup action\".",
+			Optional:    true,
+		},
+		"dry_run": schema.BoolAttribute{
+			Description: "Whether to only report the runs that would be discarded or canceled, without changing them. Defaults to `false`.",
+			Optional:    true,
+		},
+	})
+
+	resp.Schema = schema.Schema{
+		Description: "Discards, cancels or force cancels the stale runs of HCP Terraform or Terraform Enterprise workspaces, selected by ID or by project, name and tags, and by status and age." +
+			"\n\nUse it to clear the queues of workspaces after many runs were triggered at once, for example by a burst of VCS events. The runs of a workspace are cleaned up newest first, so that pending runs are discarded before the run they were waiting for. Use `dry_run` to report the runs that would be cleaned up first.",
+		Attributes: attributes,
+	}
+}
+
+func (a *actionTFERunsCleanup) ConfigValidators(ctx context.Context) []action.ConfigValidator {
+	return []action.ConfigValidator{
+		actionvalidator.AtLeastOneOf(
+			path.MatchRoot("workspace_ids"),
+			path.MatchRoot("project_id"),
+			path.MatchRoot("names"),
+			path.MatchRoot("tag_filters"),
+		),
+		actionvalidator.Conflicting(
+			path.MatchRoot("workspace_ids"),
+			path.MatchRoot("project_id"),
+		),
+		actionvalidator.Conflicting(
+			path.MatchRoot("workspace_ids"),
+			path.MatchRoot("names"),
+		),
+		actionvalidator.Conflicting(
+			path.MatchRoot("workspace_ids"),
+			path.MatchRoot("tag_filters"),
+		),
+	}
+}
+
+func (a *actionTFERunsCleanup) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data actionTFERunsCleanupModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var statuses []string
+	if data.Statuses.IsNull() {
+		statuses = defaultRunCleanupStatuses
+	} else {
+		resp.Diagnostics.Append(data.Statuses.ElementsAs(ctx, &statuses, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var cutoff time.Time
+	if !data.OlderThan.IsNull() {
+		olderThan, err := time.ParseDuration(data.OlderThan.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid older_than", err.Error())
+			return
+		}
+		cutoff = time.Now().Add(-olderThan)
+	}
+
+	comment := "Cleaned up by the tfe_runs_cleanup action"
+	if !data.Comment.IsNull() {
+		comment = data.Comment.ValueString()
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: "Selecting workspaces..."})
+
+	workspaces, diags := a.config.selectRunsCleanupWorkspaces(ctx, req.Config, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Selecting runs of %d workspace(s)...", len(workspaces)),
+	})
+
+	runs, err := listStaleRuns(ctx, a.config.Client, workspaces, statuses, cutoff)
+	if err != nil {
+		resp.Diagnostics.AddError("Error selecting runs", err.Error())
+		return
+	}
+
+	progress := func(message string) {
+		resp.SendProgress(action.InvokeProgressEvent{Message: message})
+	}
+	operation := data.Operation.ValueString()
+	dryRun := data.DryRun.ValueBool()
+	result, err := cleanupRuns(ctx, a.config.Client, runs, operation, comment, dryRun, progress)
+	progress(result.summary(operation, dryRun))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error cleaning up runs", err.Error())
+	}
+}
+
+// runStatusValues are the statuses of the runs that have not finished, which
+// are the only ones that can be discarded or canceled.
+func runStatusValues() []string {
+	return []string{
+		string(tfe.RunPending),
+		string(tfe.RunFetching),
+		string(tfe.RunFetchingCompleted),
+		string(tfe.RunPrePlanRunning),
+		string(tfe.RunPrePlanCompleted),
+		string(tfe.RunQueuing),
+		string(tfe.RunPlanQueued),
+		string(tfe.RunPlanning),
+		string(tfe.RunPlanned),
+		string(tfe.RunCostEstimating),
+		string(tfe.RunCostEstimated),
+		string(tfe.RunPolicyChecking),
+		string(tfe.RunPolicyOverride),
+		string(tfe.RunPolicySoftFailed),
+		string(tfe.RunPolicyChecked),
+		string(tfe.RunPostPlanRunning),
+		string(tfe.RunPostPlanCompleted),
+		string(tfe.RunPostPlanAwaitingDecision),
+		string(tfe.RunConfirmed),
+		string(tfe.RunQueuingApply),
+		string(tfe.RunApplyQueued),
+		string(tfe.RunApplying),
+		string(tfe.RunPreApplyRunning),
+		string(tfe.RunPreApplyCompleted),
+		string(tfe.RunPostApplyRunning),
+		string(tfe.RunPostApplyCompleted),
+	}
+}
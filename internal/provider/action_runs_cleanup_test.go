@@ -0,0 +1,119 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccTFERunsCleanupAction_discard(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	organization, orgCleanup := createOrganization(t, tfeClient, tfe.OrganizationCreateOptions{
+		Name:  tfe.String(fmt.Sprintf("tst-org-%d", rInt)),
+		Email: tfe.String("admin@terraformer.inc"),
+	})
+	t.Cleanup(orgCleanup)
+
+	parentWorkspace, _ := setupWorkspacesWithConfig(t, tfeClient, rInt, organization.Name, "test-fixtures/basic-config")
+
+	// The first run waits for a confirmation, and the second one is pending
+	// behind it.
+	var runIDs []string
+	for i := 0; i < 2; i++ {
+		run, err := tfeClient.Runs.Create(ctx, tfe.RunCreateOptions{Workspace: parentWorkspace})
+		if err != nil {
+			t.Fatal(err)
+		}
+		runIDs = append(runIDs, run.ID)
+	}
+	awaitTFERunStatus(t, tfeClient, runIDs[0], tfe.RunPlanned)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccMuxedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFERunsCleanupAction(parentWorkspace.ID, true),
+				PostApplyFunc: func() {
+					checkTFERunStatus(t, tfeClient, runIDs[0], tfe.RunPlanned)
+					checkTFERunStatus(t, tfeClient, runIDs[1], tfe.RunPending)
+				},
+			},
+			{
+				Config: testAccTFERunsCleanupAction(parentWorkspace.ID, false),
+				PostApplyFunc: func() {
+					checkTFERunStatus(t, tfeClient, runIDs[0], tfe.RunDiscarded)
+					checkTFERunStatus(t, tfeClient, runIDs[1], tfe.RunDiscarded)
+				},
+			},
+		},
+	})
+}
+
+func awaitTFERunStatus(t *testing.T, client *tfe.Client, runID string, status tfe.RunStatus) {
+	for i := 0; i < 60; i++ {
+		run, err := client.Runs.Read(context.Background(), runID)
+		if err != nil {
+			t.Fatalf("Error reading run %s: %s", runID, err)
+		}
+		if run.Status == status {
+			return
+		}
+		time.Sleep(2 * time.Second)
+	}
+	t.Fatalf("Timed out waiting for run %s to be %s", runID, status)
+}
+
+func checkTFERunStatus(t *testing.T, client *tfe.Client, runID string, status tfe.RunStatus) {
+	run, err := client.Runs.Read(context.Background(), runID)
+	if err != nil {
+		t.Fatalf("Error reading run %s: %s", runID, err)
+	}
+	if run.Status != status {
+		t.Fatalf("Expected run %s to be %s, got %s", runID, status, run.Status)
+	}
+}
+
+func testAccTFERunsCleanupAction(workspaceID string, dryRun bool) string {
+	return fmt.Sprintf(`
+resource "terraform_data" "test" {
+  input = %[2]t
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.tfe_runs_cleanup.test]
+    }
+  }
+}
+
+action "tfe_runs_cleanup" "test" {
+  config {
+    workspace_ids = ["%[1]s"]
+    operation     = "discard"
+    statuses      = ["pending", "planned"]
+    comment       = "Cleaning up the test runs"
+    dry_run       = %[2]t
+  }
+}`, workspaceID, dryRun)
+}
@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ action.Action                     = &actionTFEWorkspaceLock{}
+	_ action.ActionWithConfigure        = &actionTFEWorkspaceLock{}
+	_ action.ActionWithConfigValidators = &actionTFEWorkspaceLock{}
+)
+
+func NewWorkspaceLockAction() action.Action {
+	return &actionTFEWorkspaceLock{}
+}
+
+type actionTFEWorkspaceLock struct {
+	config ConfiguredClient
+}
+
+type actionTFEWorkspaceLockModel struct {
+	modelWorkspaceSelector
+	Reason types.String `tfsdk:"reason"`
+}
+
+func (a *actionTFEWorkspaceLock) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ConfiguredClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected action Configure type",
+			fmt.Sprintf("Expected tfe.ConfiguredClient, got %T. This is a bug in the tfe provider, so please report it on Github.", req.ProviderData),
+		)
+	}
+	a.config = client
+}
+
+func (a *actionTFEWorkspaceLock) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_lock"
+}
+
+func (a *actionTFEWorkspaceLock) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	attributes := workspaceSelectorAttributes()
+	maps.Copy(attributes, map[string]schema.Attribute{
+		"reason": schema.StringAttribute{
+			Description: "The reason for locking the workspaces. Defaults to \"Locked by the tfe_workspace_lock action\".",
+			Optional:    true,
+		},
+	})
+
+	resp.Schema = schema.Schema{
+		Description: "Locks HCP Terraform or Terraform Enterprise workspaces, selected by ID or by name and tags, so that no run can start in them." +
+			"\n\nThe action reports which workspaces it locked and which were already locked by a run, a user or a team. Workspaces that were already locked are reported in a warning and are left as is.",
+		Attributes: attributes,
+	}
+}
+
+func (a *actionTFEWorkspaceLock) ConfigValidators(ctx context.Context) []action.ConfigValidator {
+	return workspaceSelectorConfigValidators()
+}
+
+func (a *actionTFEWorkspaceLock) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data actionTFEWorkspaceLockModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: "Selecting workspaces..."})
+
+	workspaces, diags := a.config.selectConfiguredWorkspaces(ctx, req.Config, data.modelWorkspaceSelector)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reason := "Locked by the tfe_workspace_lock action"
+	if !data.Reason.IsNull() {
+		reason = data.Reason.ValueString()
+	}
+
+	progress := func(message string) {
+		resp.SendProgress(action.InvokeProgressEvent{Message: message})
+	}
+	result, err := lockWorkspaces(ctx, a.config.Client, workspaces, reason, progress)
+	progress(result.summary("Locked", "locked"))
+
+	if len(result.held) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Workspaces held by another lock",
+			fmt.Sprintf("These workspaces were already locked and were left as is: %s", strings.Join(result.held, ", ")),
+		)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error locking workspaces", err.Error())
+	}
+}
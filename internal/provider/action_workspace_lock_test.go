@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccTFEWorkspaceLockAction_byTags(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	organization, orgCleanup := createOrganization(t, tfeClient, tfe.OrganizationCreateOptions{
+		Name:  tfe.String(fmt.Sprintf("tst-org-%d", rInt)),
+		Email: tfe.String("admin@terraformer.inc"),
+	})
+	t.Cleanup(orgCleanup)
+
+	var maintained, other *tfe.Workspace
+	for _, name := range []string{"maintained", "other"} {
+		ws, err := tfeClient.Workspaces.Create(ctx, organization.Name, tfe.WorkspaceCreateOptions{
+			Name:        tfe.String(fmt.Sprintf("tst-%s-%d", name, rInt)),
+			TagBindings: []*tfe.TagBinding{{Key: "maintenance", Value: name}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if name == "maintained" {
+			maintained = ws
+		} else {
+			other = ws
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccMuxedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspaceLockAction_byTags(organization.Name),
+				PostApplyFunc: func() {
+					checkTFEWorkspaceLocked(t, tfeClient, maintained.ID, true)
+					checkTFEWorkspaceLocked(t, tfeClient, other.ID, false)
+				},
+			},
+		},
+	})
+}
+
+func checkTFEWorkspaceLocked(t *testing.T, client *tfe.Client, workspaceID string, locked bool) {
+	ws, err := client.Workspaces.ReadByID(context.Background(), workspaceID)
+	if err != nil {
+		t.Fatalf("Error reading workspace %s: %s", workspaceID, err)
+	}
+	if ws.Locked != locked {
+		t.Fatalf("Expected workspace %s to have locked %t, got %t", ws.Name, locked, ws.Locked)
+	}
+}
+
+func testAccTFEWorkspaceLockAction_byTags(organization string) string {
+	return fmt.Sprintf(`
+resource "terraform_data" "test" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.tfe_workspace_lock.test]
+    }
+  }
+}
+
+action "tfe_workspace_lock" "test" {
+  config {
+    organization = "%s"
+    reason       = "Maintenance window"
+
+    tag_filters = {
+      include = {
+        maintenance = "maintained"
+      }
+    }
+  }
+}`, organization)
+}
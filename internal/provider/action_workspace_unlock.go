@@ -0,0 +1,106 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ action.Action                     = &actionTFEWorkspaceUnlock{}
+	_ action.ActionWithConfigure        = &actionTFEWorkspaceUnlock{}
+	_ action.ActionWithConfigValidators = &actionTFEWorkspaceUnlock{}
+)
+
+func NewWorkspaceUnlockAction() action.Action {
+	return &actionTFEWorkspaceUnlock{}
+}
+
+type actionTFEWorkspaceUnlock struct {
+	config ConfiguredClient
+}
+
+type actionTFEWorkspaceUnlockModel struct {
+	modelWorkspaceSelector
+	Force types.Bool `tfsdk:"force"`
+}
+
+func (a *actionTFEWorkspaceUnlock) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ConfiguredClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected action Configure type",
+			fmt.Sprintf("Expected tfe.ConfiguredClient, got %T. This is a bug in the tfe provider, so please report it on Github.", req.ProviderData),
+		)
+	}
+	a.config = client
+}
+
+func (a *actionTFEWorkspaceUnlock) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_unlock"
+}
+
+func (a *actionTFEWorkspaceUnlock) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	attributes := workspaceSelectorAttributes()
+	maps.Copy(attributes, map[string]schema.Attribute{
+		"force": schema.BoolAttribute{
+			Description: "Whether to force unlock workspaces locked by a run or by another user or team. Requires the permission to force unlock the workspaces. Defaults to `false`.",
+			Optional:    true,
+		},
+	})
+
+	resp.Schema = schema.Schema{
+		Description: "Unlocks HCP Terraform or Terraform Enterprise workspaces, selected by ID or by name and tags." +
+			"\n\nThe action reports which workspaces it unlocked and which are held by a run or by another user or team. Without `force`, held workspaces are reported in a warning and are left locked.",
+		Attributes: attributes,
+	}
+}
+
+func (a *actionTFEWorkspaceUnlock) ConfigValidators(ctx context.Context) []action.ConfigValidator {
+	return workspaceSelectorConfigValidators()
+}
+
+func (a *actionTFEWorkspaceUnlock) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data actionTFEWorkspaceUnlockModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: "Selecting workspaces..."})
+
+	workspaces, diags := a.config.selectConfiguredWorkspaces(ctx, req.Config, data.modelWorkspaceSelector)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	progress := func(message string) {
+		resp.SendProgress(action.InvokeProgressEvent{Message: message})
+	}
+	result, err := unlockWorkspaces(ctx, a.config.Client, workspaces, data.Force.ValueBool(), progress)
+	progress(result.summary("Unlocked", "unlocked"))
+
+	if len(result.held) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Workspaces held by another lock",
+			fmt.Sprintf("These workspaces are locked by a run or by another user or team and were left locked, set force to unlock them: %s", strings.Join(result.held, ", ")),
+		)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error unlocking workspaces", err.Error())
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccTFEWorkspaceUnlockAction_byID(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	organization, orgCleanup := createOrganization(t, tfeClient, tfe.OrganizationCreateOptions{
+		Name:  tfe.String(fmt.Sprintf("tst-org-%d", rInt)),
+		Email: tfe.String("admin@terraformer.inc"),
+	})
+	t.Cleanup(orgCleanup)
+
+	ws, err := tfeClient.Workspaces.Create(ctx, organization.Name, tfe.WorkspaceCreateOptions{
+		Name: tfe.String(fmt.Sprintf("tst-locked-%d", rInt)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tfeClient.Workspaces.Lock(ctx, ws.ID, tfe.WorkspaceLockOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: testAccMuxedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspaceUnlockAction_byID(ws.ID),
+				PostApplyFunc: func() {
+					checkTFEWorkspaceLocked(t, tfeClient, ws.ID, false)
+				},
+			},
+		},
+	})
+}
+
+func testAccTFEWorkspaceUnlockAction_byID(workspaceID string) string {
+	return fmt.Sprintf(`
+resource "terraform_data" "test" {
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.tfe_workspace_unlock.test]
+    }
+  }
+}
+
+action "tfe_workspace_unlock" "test" {
+  config {
+    workspace_ids = ["%s"]
+    force         = true
+  }
+}`, workspaceID)
+}
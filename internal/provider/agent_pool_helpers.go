@@ -4,14 +4,23 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 
 	tfev2 "github.com/hashicorp/go-tfe/v2"
 	"github.com/hashicorp/go-tfe/v2/api/models"
 	"github.com/hashicorp/go-tfe/v2/api/organizations"
+	"github.com/hashicorp/terraform-provider-tfe/internal/client"
 )
 
-func fetchAgentPool(orgName string, poolName string, client *tfev2.Client) (models.AgentPoolsable, error) {
+// lookupAgentPool is fetchAgentPool backed by the lookup cache.
+func (c ConfiguredClient) lookupAgentPool(ctx context.Context, orgName string, poolName string) (models.AgentPoolsable, error) {
+	return client.Lookup(c.Lookups, client.LookupAgentPools, orgName+"/"+poolName, func() (models.AgentPoolsable, error) {
+		return fetchAgentPool(ctx, orgName, poolName, c.ClientV2)
+	})
+}
+
+func fetchAgentPool(ctx context.Context, orgName string, poolName string, client *tfev2.Client) (models.AgentPoolsable, error) {
 	// to reduce the number of pages returned, search based on the name. TFE instances which
 	// do not support agent pool search will just ignore the query parameter
 	pageSize := int32(100)
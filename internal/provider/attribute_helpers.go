@@ -20,6 +20,14 @@ func timeStringOrNull(t time.Time) types.String {
 	return types.StringValue(t.Format(time.RFC3339))
 }
 
+// stringValueOrNull returns s, or null if s is empty.
+func stringValueOrNull(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
 // AttrGettable is a small enabler for helper functions that need to read one
 // attribute of a Configuration, Plan, or State.
 type AttrGettable interface {
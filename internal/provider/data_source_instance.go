@@ -0,0 +1,227 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &dataSourceInstance{}
+	_ datasource.DataSourceWithConfigure = &dataSourceInstance{}
+)
+
+// instanceEntitlementsAttrTypes are the attributes of the entitlements of an
+// organization.
+var instanceEntitlementsAttrTypes = map[string]attr.Type{
+	"agents":                  types.BoolType,
+	"audit_logging":           types.BoolType,
+	"cost_estimation":         types.BoolType,
+	"global_run_tasks":        types.BoolType,
+	"operations":              types.BoolType,
+	"private_module_registry": types.BoolType,
+	"private_run_tasks":       types.BoolType,
+	"run_tasks":               types.BoolType,
+	"sentinel":                types.BoolType,
+	"sso":                     types.BoolType,
+	"state_storage":           types.BoolType,
+	"teams":                   types.BoolType,
+	"vcs_integrations":        types.BoolType,
+}
+
+var instanceEntitlementsDescriptions = map[string]string{
+	"agents":                  "Whether the organization can use HCP Terraform agents.",
+	"audit_logging":           "Whether the organization can use audit logging.",
+	"cost_estimation":         "Whether the organization can use cost estimation.",
+	"global_run_tasks":        "Whether the organization can use global run tasks.",
+	"operations":              "Whether the organization can run remote operations.",
+	"private_module_registry": "Whether the organization can use the private registry.",
+	"private_run_tasks":       "Whether the organization can use private run tasks.",
+	"run_tasks":               "Whether the organization can use run tasks.",
+	"sentinel":                "Whether the organization can use Sentinel and OPA policies.",
+	"sso":                     "Whether the organization can use single sign-on.",
+	"state_storage":           "Whether the organization can store state.",
+	"teams":                   "Whether the organization can manage teams.",
+	"vcs_integrations":        "Whether the organization can use VCS integrations.",
+}
+
+func NewInstanceDataSource() datasource.DataSource {
+	return &dataSourceInstance{}
+}
+
+type dataSourceInstance struct {
+	config ConfiguredClient
+}
+
+type modelInstance struct {
+	ID               types.String `tfsdk:"id"`
+	Hostname         types.String `tfsdk:"hostname"`
+	IsCloud          types.Bool   `tfsdk:"is_cloud"`
+	TFEVersion       types.String `tfsdk:"tfe_version"`
+	TFELegacyVersion types.String `tfsdk:"tfe_legacy_version"`
+	APIBaseURL       types.String `tfsdk:"api_base_url"`
+	APIVersion       types.String `tfsdk:"api_version"`
+	Services         types.Map    `tfsdk:"services"`
+	Organization     types.String `tfsdk:"organization"`
+	Entitlements     types.Object `tfsdk:"entitlements"`
+}
+
+func (d *dataSourceInstance) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_instance"
+}
+
+func (d *dataSourceInstance) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	entitlements := make(map[string]schema.Attribute, len(instanceEntitlementsAttrTypes))
+	for name, description := range instanceEntitlementsDescriptions {
+		entitlements[name] = schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: description,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get information about the HCP Terraform or Terraform Enterprise instance the provider is configured for, such as its version, the services it provides, and the entitlements of an organization. Use it to make modules that are shared between HCP Terraform and Terraform Enterprise depend on what the instance supports.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The hostname of the instance.",
+			},
+			"hostname": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The hostname of the instance.",
+			},
+			"is_cloud": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the instance is HCP Terraform rather than Terraform Enterprise.",
+			},
+			"tfe_version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The numeric version of Terraform Enterprise, such as `1.0.0`. Null for HCP Terraform and for Terraform Enterprise releases that only report a `tfe_legacy_version`.",
+			},
+			"tfe_legacy_version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The release version of Terraform Enterprise, such as `v202402-1`. Null for HCP Terraform.",
+			},
+			"api_base_url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The base URL of the API discovered for the instance, such as `https://app.terraform.io/api/v2/`.",
+			},
+			"api_version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The API version reported by the instance, such as `2.6`.",
+			},
+			"services": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The services discovered on the instance, such as `tfe.v2.2` or `modules.v1`, mapped to their URLs.",
+			},
+			"organization": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The name of the organization whose entitlements are read. Defaults to the provider organization. When neither is set, `entitlements` is null.",
+			},
+			"entitlements": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The entitlements of the organization, which depend on its plan on HCP Terraform and on the license of Terraform Enterprise.",
+				Attributes:          entitlements,
+			},
+		},
+	}
+}
+
+func (d *dataSourceInstance) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ConfiguredClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected ConfiguredClient, got %T. This is a bug in the tfe provider, so please report it on GitHub.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.config = client
+}
+
+func (d *dataSourceInstance) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data modelInstance
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading instance")
+
+	baseURL := d.config.Client.BaseURL()
+	services, diags := types.MapValueFrom(ctx, types.StringType, d.config.Services)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(baseURL.Host)
+	data.Hostname = types.StringValue(baseURL.Host)
+	data.IsCloud = types.BoolValue(d.config.Client.IsCloud())
+	data.TFEVersion = stringValueOrNull(d.config.Client.RemoteTFENumericVersion())
+	data.TFELegacyVersion = stringValueOrNull(d.config.Client.RemoteTFEVersion())
+	data.APIBaseURL = types.StringValue(baseURL.String())
+	data.APIVersion = types.StringValue(d.config.Client.RemoteAPIVersion())
+	data.Services = services
+
+	organization := data.Organization.ValueString()
+	if organization == "" {
+		organization = d.config.Organization
+	}
+
+	data.Entitlements = types.ObjectNull(instanceEntitlementsAttrTypes)
+	if organization == "" {
+		data.Organization = types.StringNull()
+	} else {
+		data.Organization = types.StringValue(organization)
+
+		entitlements, err := d.config.Client.Organizations.ReadEntitlements(ctx, organization)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to read organization entitlements",
+				fmt.Sprintf("Error reading entitlements of organization %s: %s", organization, err),
+			)
+			return
+		}
+
+		data.Entitlements, diags = instanceEntitlementsValue(entitlements)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Read instance successfully", map[string]any{
+		"hostname": baseURL.Host,
+		"is_cloud": data.IsCloud.ValueBool(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func instanceEntitlementsValue(e *tfe.Entitlements) (types.Object, diag.Diagnostics) {
+	values := make(map[string]attr.Value, len(instanceEntitlementsAttrTypes))
+	for name := range instanceEntitlementsAttrTypes {
+		values[name] = types.BoolValue(entitlementGetters[name](e))
+	}
+	return types.ObjectValue(instanceEntitlementsAttrTypes, values)
+}
@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTFEInstanceDataSource_basic(t *testing.T) {
+	resourceAddress := "data.tfe_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "tfe_instance" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceAddress, "hostname"),
+					resource.TestCheckResourceAttrPair(resourceAddress, "id", resourceAddress, "hostname"),
+					resource.TestCheckResourceAttrSet(resourceAddress, "is_cloud"),
+					resource.TestCheckResourceAttrSet(resourceAddress, "api_base_url"),
+					resource.TestCheckResourceAttrSet(resourceAddress, "api_version"),
+					resource.TestCheckResourceAttrSet(resourceAddress, "services.tfe.v2.2"),
+					resource.TestCheckNoResourceAttr(resourceAddress, "organization"),
+					resource.TestCheckNoResourceAttr(resourceAddress, "entitlements.%"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTFEInstanceDataSource_entitlements(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+	orgName := fmt.Sprintf("tst-terraform-foo-%d", rInt)
+	resourceAddress := "data.tfe_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEInstanceDataSourceConfig_entitlements(orgName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceAddress, "organization", orgName),
+					resource.TestCheckResourceAttr(resourceAddress, "entitlements.operations", "true"),
+					resource.TestCheckResourceAttr(resourceAddress, "entitlements.state_storage", "true"),
+					resource.TestCheckResourceAttrSet(resourceAddress, "entitlements.teams"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTFEInstanceDataSourceConfig_entitlements(orgName string) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "%s"
+  email = "admin@company.com"
+}
+
+data "tfe_instance" "test" {
+  organization = tfe_organization.foobar.name
+}`, orgName)
+}
@@ -10,18 +10,18 @@ package provider
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceTFEOAuthClient() *schema.Resource {
 	return &schema.Resource{
-		Description: "Gets information about an OAuth client.",
-		Read:        dataSourceTFEOAuthClientRead,
+		Description:        "Gets information about an OAuth client.",
+		ReadWithoutTimeout: dataSourceTFEOAuthClientRead,
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Description: "The OAuth client ID. This will match `oauth_client_id`.",
@@ -113,8 +113,7 @@ func dataSourceTFEOAuthClient() *schema.Resource {
 	}
 }
 
-func dataSourceTFEOAuthClientRead(d *schema.ResourceData, meta interface{}) error {
-	ctx := context.TODO()
+func dataSourceTFEOAuthClientRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	var oc *tfe.OAuthClient
@@ -124,13 +123,13 @@ func dataSourceTFEOAuthClientRead(d *schema.ResourceData, meta interface{}) erro
 	case ok:
 		oc, err = config.Client.OAuthClients.Read(ctx, v.(string))
 		if err != nil {
-			return fmt.Errorf("Error retrieving OAuth client: %w", err)
+			return diag.Errorf("Error retrieving OAuth client: %s", err)
 		}
 	default:
 		// search by name or service provider within a specific organization instead
 		organization, err := config.schemaOrDefaultOrganization(d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		var name string
@@ -146,7 +145,7 @@ func dataSourceTFEOAuthClientRead(d *schema.ResourceData, meta interface{}) erro
 
 		oc, err = fetchOAuthClientByNameOrServiceProvider(ctx, config.Client, organization, name, serviceProvider)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -169,7 +168,7 @@ func dataSourceTFEOAuthClientRead(d *schema.ResourceData, meta interface{}) erro
 	case 1:
 		d.Set("oauth_token_id", oc.OAuthTokens[0].ID)
 	default:
-		return fmt.Errorf("unexpected number of OAuth tokens: %d", len(oc.OAuthTokens))
+		return diag.Errorf("unexpected number of OAuth tokens: %d", len(oc.OAuthTokens))
 	}
 
 	var projectIDs []interface{}
@@ -9,6 +9,8 @@
 package provider
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -16,7 +18,7 @@ func dataSourceTFEOrganizationMembers() *schema.Resource {
 	return &schema.Resource{
 		Description: "Gets information about members of an organization.",
 
-		Read: dataSourceTFEOrganizationMembersRead,
+		ReadWithoutTimeout: dataSourceTFEOrganizationMembersRead,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -76,17 +78,17 @@ func dataSourceTFEOrganizationMembers() *schema.Resource {
 	}
 }
 
-func dataSourceTFEOrganizationMembersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTFEOrganizationMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	organizationName, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	members, membersWaiting, err := fetchOrganizationMembers(config.ClientV2, organizationName)
+	members, membersWaiting, err := config.lookupOrganizationMembers(ctx, organizationName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("members", members)
@@ -11,11 +11,11 @@ package provider
 import (
 	"context"
 	"errors"
-	"fmt"
 
 	tfev2 "github.com/hashicorp/go-tfe/v2"
 	"github.com/hashicorp/go-tfe/v2/api/organizationmemberships"
 	membershipitem "github.com/hashicorp/go-tfe/v2/api/organizationmemberships/item"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -26,7 +26,7 @@ func dataSourceTFEOrganizationMembership() *schema.Resource {
 			"\n\n~> **Note:** If a user updates their email address, configurations using the email address should be updated manually." +
 			"\n\n-> **Note**: While `email` and `username` are optional arguments, one or the other is required if `organization_membership_id` argument is not provided.",
 
-		Read: dataSourceTFEOrganizationMembershipRead,
+		ReadWithoutTimeout: dataSourceTFEOrganizationMembershipRead,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -71,7 +71,7 @@ func dataSourceTFEOrganizationMembership() *schema.Resource {
 	}
 }
 
-func dataSourceTFEOrganizationMembershipRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTFEOrganizationMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the user email and organization.
@@ -81,13 +81,13 @@ func dataSourceTFEOrganizationMembershipRead(d *schema.ResourceData, meta interf
 
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if orgMemberID == "" {
-		orgMember, err := fetchOrganizationMemberByNameOrEmailV2(context.Background(), config.ClientV2, organization, username, email)
+		orgMember, err := config.lookupOrganizationMemberByNameOrEmailV2(ctx, organization, username, email)
 		if err != nil {
-			return fmt.Errorf("could not find organization membership for organization %s: %w", organization, err)
+			return diag.Errorf("could not find organization membership for organization %s: %s", organization, err)
 		}
 
 		orgMemberID = valueOrZero(orgMember.GetId())
@@ -95,7 +95,7 @@ func dataSourceTFEOrganizationMembershipRead(d *schema.ResourceData, meta interf
 
 	d.SetId(orgMemberID)
 
-	membershipResponse, err := config.ClientV2.API.OrganizationMemberships().ByOrganization_membership_id(orgMemberID).Get(context.Background(), withQueryParams(&organizationmemberships.WithOrganization_membership_ItemRequestBuilderGetQueryParameters{
+	membershipResponse, err := config.ClientV2.API.OrganizationMemberships().ByOrganization_membership_id(orgMemberID).Get(ctx, withQueryParams(&organizationmemberships.WithOrganization_membership_ItemRequestBuilderGetQueryParameters{
 		Include: []membershipitem.GetIncludeQueryParameterType{membershipitem.USER_GETINCLUDEQUERYPARAMETERTYPE},
 	}))
 	if err != nil {
@@ -103,12 +103,12 @@ func dataSourceTFEOrganizationMembershipRead(d *schema.ResourceData, meta interf
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error reading configuration of membership %s: %w", orgMemberID, err)
+		return diag.Errorf("error reading configuration of membership %s: %s", orgMemberID, err)
 	}
 
 	membership := membershipResponse.GetData()
 	if membership == nil {
-		return fmt.Errorf("error reading configuration of membership %s: response contained no membership data", orgMemberID)
+		return diag.Errorf("error reading configuration of membership %s: response contained no membership data", orgMemberID)
 	}
 
 	var membershipEmail string
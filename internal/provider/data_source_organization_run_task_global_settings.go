@@ -115,7 +115,7 @@ func (d *dataSourceOrganizationRunTaskGlobalSettings) Read(ctx context.Context,
 		return
 	}
 
-	result := dataModelFromTFEOrganizationRunTaskGlobalSettingsV2(task)
+	result := dataModelFromTFEOrganizationRunTaskGlobalSettingsV2(ctx, task)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
@@ -133,7 +133,7 @@ func taskGlobalConfiguration(task models.Tasksable) models.Tasks_attributes_glob
 // dataModelFromTFEOrganizationRunTaskGlobalSettingsV2 builds the shared
 // modelDataTFEOrganizationRunTaskGlobalSettings model from a go-tfe v2 task.
 // It is used by both this data source and the tfe_organization_run_task_global_settings resource.
-func dataModelFromTFEOrganizationRunTaskGlobalSettingsV2(v models.Tasksable) modelDataTFEOrganizationRunTaskGlobalSettings {
+func dataModelFromTFEOrganizationRunTaskGlobalSettingsV2(ctx context.Context, v models.Tasksable) modelDataTFEOrganizationRunTaskGlobalSettings {
 	result := modelDataTFEOrganizationRunTaskGlobalSettings{
 		Enabled:          types.BoolNull(),
 		ID:               types.StringValue(valueOrZero(v.GetId())),
@@ -0,0 +1,430 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &dataSourceTFEPlanJSON{}
+	_ datasource.DataSourceWithConfigure = &dataSourceTFEPlanJSON{}
+)
+
+// planResourceChangeType is the type of the summary of a resource change.
+var planResourceChangeType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"address":          types.StringType,
+	"previous_address": types.StringType,
+	"module_address":   types.StringType,
+	"mode":             types.StringType,
+	"type":             types.StringType,
+	"name":             types.StringType,
+	"provider_name":    types.StringType,
+	"actions":          types.ListType{ElemType: types.StringType},
+	"action_reason":    types.StringType,
+}}
+
+// planOutputChangeType is the type of the summary of an output change.
+var planOutputChangeType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"actions":   types.ListType{ElemType: types.StringType},
+	"sensitive": types.BoolType,
+}}
+
+// planStateResourceType is the type of the summary of a resource of the prior
+// state.
+var planStateResourceType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"address":       types.StringType,
+	"mode":          types.StringType,
+	"type":          types.StringType,
+	"name":          types.StringType,
+	"provider_name": types.StringType,
+}}
+
+// planJSON is the subset of the JSON plan representation the data source
+// summarizes. See https://developer.hashicorp.com/terraform/internals/json-format.
+type planJSON struct {
+	TerraformVersion string                    `json:"terraform_version"`
+	ResourceChanges  []planJSONResourceChange  `json:"resource_changes"`
+	OutputChanges    map[string]planJSONChange `json:"output_changes"`
+	PriorState       *planJSONState            `json:"prior_state"`
+}
+
+type planJSONResourceChange struct {
+	Address         string         `json:"address"`
+	PreviousAddress string         `json:"previous_address"`
+	ModuleAddress   string         `json:"module_address"`
+	Mode            string         `json:"mode"`
+	Type            string         `json:"type"`
+	Name            string         `json:"name"`
+	ProviderName    string         `json:"provider_name"`
+	Change          planJSONChange `json:"change"`
+	ActionReason    string         `json:"action_reason"`
+}
+
+type planJSONChange struct {
+	Actions        []string        `json:"actions"`
+	AfterSensitive json.RawMessage `json:"after_sensitive"`
+}
+
+type planJSONState struct {
+	TerraformVersion string `json:"terraform_version"`
+	Values           *struct {
+		RootModule planJSONModule `json:"root_module"`
+	} `json:"values"`
+}
+
+type planJSONModule struct {
+	Resources    []planJSONStateResource `json:"resources"`
+	ChildModules []planJSONModule        `json:"child_modules"`
+}
+
+type planJSONStateResource struct {
+	Address      string `json:"address"`
+	Mode         string `json:"mode"`
+	Type         string `json:"type"`
+	Name         string `json:"name"`
+	ProviderName string `json:"provider_name"`
+}
+
+// NewPlanJSONDataSource is a helper function to simplify the provider implementation.
+func NewPlanJSONDataSource() datasource.DataSource {
+	return &dataSourceTFEPlanJSON{}
+}
+
+// dataSourceTFEPlanJSON is the data source implementation.
+type dataSourceTFEPlanJSON struct {
+	config ConfiguredClient
+}
+
+// modelTFEPlanJSON maps the data source schema data.
+type modelTFEPlanJSON struct {
+	ID                 types.String  `tfsdk:"id"`
+	RunID              types.String  `tfsdk:"run_id"`
+	WorkspaceID        types.String  `tfsdk:"workspace_id"`
+	TerraformVersion   types.String  `tfsdk:"terraform_version"`
+	ResourceChanges    types.Dynamic `tfsdk:"resource_changes"`
+	OutputChanges      types.Dynamic `tfsdk:"output_changes"`
+	PriorState         types.Dynamic `tfsdk:"prior_state"`
+	CreatedAddresses   types.Set     `tfsdk:"created_addresses"`
+	UpdatedAddresses   types.Set     `tfsdk:"updated_addresses"`
+	DestroyedAddresses types.Set     `tfsdk:"destroyed_addresses"`
+}
+
+// Metadata returns the data source type name.
+func (d *dataSourceTFEPlanJSON) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_plan_json"
+}
+
+// Schema defines the schema for the data source.
+func (d *dataSourceTFEPlanJSON) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Gets a summary of the JSON execution plan of a run, or of the latest run of a workspace, such as the addresses of the resources it creates, updates or destroys." +
+			"\n\nThe plan of the run must have finished. Reading the JSON execution plan requires permission to read the state versions of the workspace. The summaries do not include the values of the resources and outputs.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the plan.",
+				Computed:    true,
+			},
+			"run_id": schema.StringAttribute{
+				Description: "ID of the run to read the plan of. Exactly one of `run_id` or `workspace_id` must be provided.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRelative().AtParent().AtName("workspace_id"),
+					),
+				},
+			},
+			"workspace_id": schema.StringAttribute{
+				Description: "ID of the workspace to read the plan of the latest run of. The most recent run whose plan has finished is read, skipping newer runs that are still planning or whose plan errored or was canceled. Exactly one of `run_id` or `workspace_id` must be provided.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"terraform_version": schema.StringAttribute{
+				Description: "Version of Terraform that created the plan.",
+				Computed:    true,
+			},
+			"resource_changes": schema.DynamicAttribute{
+				Description: "List of the resource changes of the plan. Each change has an `address`, `previous_address`, `module_address`, `mode`, `type`, `name`, `provider_name`, the list of `actions`, such as `[\"delete\", \"create\"]`, and an `action_reason`.",
+				Computed:    true,
+			},
+			"output_changes": schema.DynamicAttribute{
+				Description: "Output changes of the plan, keyed by output name. Each change has the list of `actions` and whether the output is `sensitive`.",
+				Computed:    true,
+			},
+			"prior_state": schema.DynamicAttribute{
+				Description: "Summary of the state the plan was created from, with its `terraform_version` and the list of its `resources`, including the resources of child modules. Each resource has an `address`, `mode`, `type`, `name` and `provider_name`. Null when the workspace had no state.",
+				Computed:    true,
+			},
+			"created_addresses": schema.SetAttribute{
+				Description: "Addresses of the resources the plan creates, including the resources it replaces.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"updated_addresses": schema.SetAttribute{
+				Description: "Addresses of the resources the plan updates in place.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"destroyed_addresses": schema.SetAttribute{
+				Description: "Addresses of the resources the plan destroys, including the resources it replaces.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *dataSourceTFEPlanJSON) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ConfiguredClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected tfe.ConfiguredClient, got %T. This is a bug in the tfe provider, so please report it on GitHub.", req.ProviderData),
+		)
+
+		return
+	}
+	d.config = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dataSourceTFEPlanJSON) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model modelTFEPlanJSON
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.config.Client
+	runID := model.RunID.ValueString()
+	if runID == "" {
+		workspaceID := model.WorkspaceID.ValueString()
+		run, err := latestFinishedPlanRun(ctx, client, workspaceID)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to list runs", err.Error())
+			return
+		}
+		if run == nil {
+			resp.Diagnostics.AddError("No finished plan found", fmt.Sprintf("Workspace %s has no run whose plan has finished", workspaceID))
+			return
+		}
+		runID = run.ID
+	}
+
+	run, err := client.Runs.ReadWithOptions(ctx, runID, &tfe.RunReadOptions{
+		Include: []tfe.RunIncludeOpt{tfe.RunPlan},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read run", fmt.Sprintf("Error reading run %s: %s", runID, err))
+		return
+	}
+	if run.Plan == nil || run.Plan.Status != tfe.PlanFinished {
+		status := "unknown"
+		if run.Plan != nil {
+			status = string(run.Plan.Status)
+		}
+		resp.Diagnostics.AddError(
+			"Plan not finished",
+			fmt.Sprintf("The plan of run %s has not finished, its status is %s. The JSON execution plan is only available once the plan has finished.", run.ID, status),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Reading JSON execution plan", map[string]any{"run_id": run.ID, "plan_id": run.Plan.ID})
+	raw, err := client.Plans.ReadJSONOutput(ctx, run.Plan.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read JSON execution plan", fmt.Sprintf("Error reading the JSON execution plan of run %s: %s", run.ID, err))
+		return
+	}
+
+	var plan planJSON
+	if err := json.Unmarshal(raw, &plan); err != nil {
+		resp.Diagnostics.AddError("Unable to parse JSON execution plan", fmt.Sprintf("Error parsing the JSON execution plan of run %s: %s", run.ID, err))
+		return
+	}
+
+	model.ID = types.StringValue(run.Plan.ID)
+	model.RunID = types.StringValue(run.ID)
+	model.WorkspaceID = types.StringNull()
+	if run.Workspace != nil {
+		model.WorkspaceID = types.StringValue(run.Workspace.ID)
+	}
+	resp.Diagnostics.Append(model.setPlan(plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save model into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// latestFinishedPlanRun returns the most recent run of the workspace whose plan
+// has finished, whatever happened to the run afterwards, or nil when there is
+// none. Runs are listed newest first, so the pages are read until one is found.
+func latestFinishedPlanRun(ctx context.Context, client *tfe.Client, workspaceID string) (*tfe.Run, error) {
+	options := &tfe.RunListOptions{
+		Include: []tfe.RunIncludeOpt{tfe.RunPlan},
+	}
+
+	for {
+		tflog.Debug(ctx, "Listing runs", map[string]any{"workspace_id": workspaceID, "page": options.PageNumber})
+		rl, err := client.Runs.List(ctx, workspaceID, options)
+		if err != nil {
+			return nil, fmt.Errorf("error listing runs of workspace %s: %w", workspaceID, err)
+		}
+
+		for _, run := range rl.Items {
+			if run.Plan != nil && run.Plan.Status == tfe.PlanFinished {
+				return run, nil
+			}
+		}
+
+		// Exit the loop when we've seen all pages.
+		if rl.Pagination == nil || rl.CurrentPage >= rl.TotalPages {
+			return nil, nil
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = rl.NextPage
+	}
+}
+
+// setPlan sets the summaries and the addresses of the plan in the model.
+func (m *modelTFEPlanJSON) setPlan(plan planJSON) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.TerraformVersion = stringValueOrNull(plan.TerraformVersion)
+
+	var created, updated, destroyed []string
+	changes := make([]attr.Value, 0, len(plan.ResourceChanges))
+	for _, rc := range plan.ResourceChanges {
+		actions := rc.Change.Actions
+		if slices.Contains(actions, "create") {
+			created = append(created, rc.Address)
+		}
+		if slices.Equal(actions, []string{"update"}) {
+			updated = append(updated, rc.Address)
+		}
+		if slices.Contains(actions, "delete") {
+			destroyed = append(destroyed, rc.Address)
+		}
+
+		change, d := types.ObjectValue(planResourceChangeType.AttrTypes, map[string]attr.Value{
+			"address":          types.StringValue(rc.Address),
+			"previous_address": stringValueOrNull(rc.PreviousAddress),
+			"module_address":   stringValueOrNull(rc.ModuleAddress),
+			"mode":             types.StringValue(rc.Mode),
+			"type":             types.StringValue(rc.Type),
+			"name":             types.StringValue(rc.Name),
+			"provider_name":    types.StringValue(rc.ProviderName),
+			"actions":          planActionsValue(actions),
+			"action_reason":    stringValueOrNull(rc.ActionReason),
+		})
+		diags.Append(d...)
+		changes = append(changes, change)
+	}
+	resourceChanges, d := types.ListValue(planResourceChangeType, changes)
+	diags.Append(d...)
+	m.ResourceChanges = types.DynamicValue(resourceChanges)
+
+	outputs := make(map[string]attr.Value, len(plan.OutputChanges))
+	for name, oc := range plan.OutputChanges {
+		output, d := types.ObjectValue(planOutputChangeType.AttrTypes, map[string]attr.Value{
+			"actions":   planActionsValue(oc.Actions),
+			"sensitive": types.BoolValue(string(oc.AfterSensitive) == "true"),
+		})
+		diags.Append(d...)
+		outputs[name] = output
+	}
+	outputChanges, d := types.MapValue(planOutputChangeType, outputs)
+	diags.Append(d...)
+	m.OutputChanges = types.DynamicValue(outputChanges)
+
+	m.PriorState = types.DynamicNull()
+	if plan.PriorState != nil {
+		var resources []attr.Value
+		if plan.PriorState.Values != nil {
+			for _, r := range planStateResources(plan.PriorState.Values.RootModule) {
+				resource, d := types.ObjectValue(planStateResourceType.AttrTypes, map[string]attr.Value{
+					"address":       types.StringValue(r.Address),
+					"mode":          types.StringValue(r.Mode),
+					"type":          types.StringValue(r.Type),
+					"name":          types.StringValue(r.Name),
+					"provider_name": types.StringValue(r.ProviderName),
+				})
+				diags.Append(d...)
+				resources = append(resources, resource)
+			}
+		}
+		resourceList, d := types.ListValue(planStateResourceType, resources)
+		diags.Append(d...)
+		priorState, d := types.ObjectValue(map[string]attr.Type{
+			"terraform_version": types.StringType,
+			"resources":         types.ListType{ElemType: planStateResourceType},
+		}, map[string]attr.Value{
+			"terraform_version": stringValueOrNull(plan.PriorState.TerraformVersion),
+			"resources":         resourceList,
+		})
+		diags.Append(d...)
+		m.PriorState = types.DynamicValue(priorState)
+	}
+
+	m.CreatedAddresses = planAddressesValue(created)
+	m.UpdatedAddresses = planAddressesValue(updated)
+	m.DestroyedAddresses = planAddressesValue(destroyed)
+
+	return diags
+}
+
+func planActionsValue(actions []string) types.List {
+	values := make([]attr.Value, 0, len(actions))
+	for _, action := range actions {
+		values = append(values, types.StringValue(action))
+	}
+	return types.ListValueMust(types.StringType, values)
+}
+
+// planAddressesValue is the set of the addresses. An address is listed once
+// even when the plan destroys both its current and its deposed objects.
+func planAddressesValue(addresses []string) types.Set {
+	slices.Sort(addresses)
+	values := []attr.Value{}
+	for _, address := range slices.Compact(addresses) {
+		values = append(values, types.StringValue(address))
+	}
+	return types.SetValueMust(types.StringType, values)
+}
+
+// planStateResources lists the resources of the module and of its child
+// modules.
+func planStateResources(module planJSONModule) []planJSONStateResource {
+	resources := slices.Clone(module.Resources)
+	for _, child := range module.ChildModules {
+		resources = append(resources, planStateResources(child)...)
+	}
+	return resources
+}
@@ -0,0 +1,274 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"regexp"
+	"testing"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	tfemocks "github.com/hashicorp/go-tfe/mocks"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"go.uber.org/mock/gomock"
+)
+
+const testPlanJSON = `{
+  "format_version": "1.2",
+  "terraform_version": "1.14.0",
+  "resource_changes": [
+    {
+      "address": "aws_db_instance.main",
+      "mode": "managed",
+      "type": "aws_db_instance",
+      "name": "main",
+      "provider_name": "registry.terraform.io/hashicorp/aws",
+      "change": {"actions": ["delete", "create"], "before": {}, "after": {}},
+      "action_reason": "replace_because_cannot_update"
+    },
+    {
+      "address": "module.network.aws_vpc.main",
+      "module_address": "module.network",
+      "mode": "managed",
+      "type": "aws_vpc",
+      "name": "main",
+      "provider_name": "registry.terraform.io/hashicorp/aws",
+      "change": {"actions": ["update"]}
+    },
+    {
+      "address": "aws_s3_bucket.logs",
+      "mode": "managed",
+      "type": "aws_s3_bucket",
+      "name": "logs",
+      "provider_name": "registry.terraform.io/hashicorp/aws",
+      "change": {"actions": ["delete"]}
+    },
+    {
+      "address": "aws_s3_bucket.logs",
+      "mode": "managed",
+      "type": "aws_s3_bucket",
+      "name": "logs",
+      "provider_name": "registry.terraform.io/hashicorp/aws",
+      "deposed": "00000001",
+      "change": {"actions": ["delete"]}
+    },
+    {
+      "address": "random_pet.name",
+      "mode": "managed",
+      "type": "random_pet",
+      "name": "name",
+      "provider_name": "registry.terraform.io/hashicorp/random",
+      "change": {"actions": ["no-op"]}
+    }
+  ],
+  "output_changes": {
+    "endpoint": {"actions": ["update"], "after_sensitive": false},
+    "password": {"actions": ["create"], "after_sensitive": true}
+  },
+  "prior_state": {
+    "terraform_version": "1.13.0",
+    "values": {
+      "root_module": {
+        "resources": [
+          {"address": "aws_db_instance.main", "mode": "managed", "type": "aws_db_instance", "name": "main", "provider_name": "registry.terraform.io/hashicorp/aws"}
+        ],
+        "child_modules": [
+          {
+            "address": "module.network",
+            "resources": [
+              {"address": "module.network.aws_vpc.main", "mode": "managed", "type": "aws_vpc", "name": "main", "provider_name": "registry.terraform.io/hashicorp/aws"}
+            ]
+          }
+        ]
+      }
+    }
+  }
+}`
+
+func TestModelTFEPlanJSON_setPlan(t *testing.T) {
+	var plan planJSON
+	if err := json.Unmarshal([]byte(testPlanJSON), &plan); err != nil {
+		t.Fatalf("unexpected error parsing the plan: %s", err)
+	}
+
+	var model modelTFEPlanJSON
+	if diags := model.setPlan(plan); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if model.TerraformVersion.ValueString() != "1.14.0" {
+		t.Fatalf("expected terraform_version 1.14.0, got %s", model.TerraformVersion)
+	}
+
+	expectAddresses := func(name string, set types.Set, expected ...string) {
+		t.Helper()
+		var addresses []string
+		for _, v := range set.Elements() {
+			addresses = append(addresses, v.(types.String).ValueString())
+		}
+		if fmt.Sprint(addresses) != fmt.Sprint(expected) {
+			t.Fatalf("expected %s %v, got %v", name, expected, addresses)
+		}
+	}
+	expectAddresses("created_addresses", model.CreatedAddresses, "aws_db_instance.main")
+	expectAddresses("updated_addresses", model.UpdatedAddresses, "module.network.aws_vpc.main")
+	expectAddresses("destroyed_addresses", model.DestroyedAddresses, "aws_db_instance.main", "aws_s3_bucket.logs")
+
+	resourceChanges, ok := model.ResourceChanges.UnderlyingValue().(types.List)
+	if !ok || len(resourceChanges.Elements()) != 5 {
+		t.Fatalf("expected 5 resource changes, got %s", model.ResourceChanges)
+	}
+	change := resourceChanges.Elements()[0].(types.Object).Attributes()
+	if change["action_reason"].(types.String).ValueString() != "replace_because_cannot_update" || !change["module_address"].IsNull() {
+		t.Fatalf("unexpected resource change: %s", change)
+	}
+
+	outputChanges, ok := model.OutputChanges.UnderlyingValue().(types.Map)
+	if !ok {
+		t.Fatalf("expected a map of output changes, got %s", model.OutputChanges)
+	}
+	password := outputChanges.Elements()["password"].(types.Object).Attributes()
+	if !password["sensitive"].(types.Bool).ValueBool() {
+		t.Fatalf("expected the password output to be sensitive, got %s", password)
+	}
+
+	priorState, ok := model.PriorState.UnderlyingValue().(types.Object)
+	if !ok {
+		t.Fatalf("expected a prior state, got %s", model.PriorState)
+	}
+	if resources := priorState.Attributes()["resources"].(types.List); len(resources.Elements()) != 2 {
+		t.Fatalf("expected the resources of the root and child modules, got %s", resources)
+	}
+}
+
+func TestModelTFEPlanJSON_setPlanWithoutPriorState(t *testing.T) {
+	var model modelTFEPlanJSON
+	if diags := model.setPlan(planJSON{TerraformVersion: "1.14.0"}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if !model.PriorState.IsNull() {
+		t.Fatalf("expected no prior state, got %s", model.PriorState)
+	}
+	if resourceChanges := model.ResourceChanges.UnderlyingValue().(types.List); len(resourceChanges.Elements()) != 0 {
+		t.Fatalf("expected no resource changes, got %s", resourceChanges)
+	}
+	if len(model.CreatedAddresses.Elements()) != 0 || model.CreatedAddresses.IsNull() {
+		t.Fatalf("expected an empty set of created addresses, got %s", model.CreatedAddresses)
+	}
+}
+
+func TestLatestFinishedPlanRun(t *testing.T) {
+	runWithPlan := func(id string, status tfe.PlanStatus) *tfe.Run {
+		return &tfe.Run{ID: id, Plan: &tfe.Plan{ID: "plan-" + id, Status: status}}
+	}
+	firstPage := &tfe.RunListOptions{Include: []tfe.RunIncludeOpt{tfe.RunPlan}}
+	secondPage := &tfe.RunListOptions{Include: []tfe.RunIncludeOpt{tfe.RunPlan}, ListOptions: tfe.ListOptions{PageNumber: 2}}
+
+	t.Run("skips runs without a finished plan", func(t *testing.T) {
+		client := testTfeClient(t, testClientOptions{})
+		ctrl := gomock.NewController(t)
+		mockRunsAPI := tfemocks.NewMockRuns(ctrl)
+		gomock.InOrder(
+			mockRunsAPI.EXPECT().List(gomock.Any(), "ws-01", firstPage).Return(&tfe.RunList{
+				Pagination: &tfe.Pagination{CurrentPage: 1, NextPage: 2, TotalPages: 2},
+				Items:      []*tfe.Run{runWithPlan("run-04", tfe.PlanRunning), runWithPlan("run-03", tfe.PlanErrored)},
+			}, nil),
+			mockRunsAPI.EXPECT().List(gomock.Any(), "ws-01", secondPage).Return(&tfe.RunList{
+				Pagination: &tfe.Pagination{CurrentPage: 2, TotalPages: 2},
+				Items:      []*tfe.Run{runWithPlan("run-02", tfe.PlanFinished), runWithPlan("run-01", tfe.PlanFinished)},
+			}, nil),
+		)
+		client.Runs = mockRunsAPI
+
+		run, err := latestFinishedPlanRun(context.Background(), client, "ws-01")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if run == nil || run.ID != "run-02" {
+			t.Fatalf("expected run-02, got %v", run)
+		}
+	})
+
+	t.Run("returns nil without a finished plan", func(t *testing.T) {
+		client := testTfeClient(t, testClientOptions{})
+		ctrl := gomock.NewController(t)
+		mockRunsAPI := tfemocks.NewMockRuns(ctrl)
+		mockRunsAPI.EXPECT().List(gomock.Any(), "ws-01", firstPage).Return(&tfe.RunList{
+			Pagination: &tfe.Pagination{CurrentPage: 1, TotalPages: 1},
+			Items:      []*tfe.Run{runWithPlan("run-01", tfe.PlanCanceled)},
+		}, nil)
+		client.Runs = mockRunsAPI
+
+		run, err := latestFinishedPlanRun(context.Background(), client, "ws-01")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if run != nil {
+			t.Fatalf("expected no run, got %s", run.ID)
+		}
+	})
+}
+
+func TestAccTFEPlanJSONDataSource_basic(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	organization, orgCleanup := createOrganization(t, tfeClient, tfe.OrganizationCreateOptions{
+		Name:  tfe.String(fmt.Sprintf("tst-org-%d", rInt)),
+		Email: tfe.String("admin@terraformer.inc"),
+	})
+	t.Cleanup(orgCleanup)
+
+	parentWorkspace, _ := setupWorkspacesWithConfig(t, tfeClient, rInt, organization.Name, "test-fixtures/basic-config")
+	run := createAppliedTFERun(t, tfeClient, parentWorkspace)
+	resourceAddress := "data.tfe_plan_json.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEPlanJSONDataSourceConfig(fmt.Sprintf(`run_id = "%s"`, run.ID)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceAddress, "run_id", run.ID),
+					resource.TestCheckResourceAttr(resourceAddress, "workspace_id", parentWorkspace.ID),
+					resource.TestCheckResourceAttrSet(resourceAddress, "id"),
+					resource.TestCheckResourceAttrSet(resourceAddress, "terraform_version"),
+					resource.TestCheckResourceAttr(resourceAddress, "created_addresses.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceAddress, "created_addresses.*", "random_pet.always_new"),
+					resource.TestCheckResourceAttr(resourceAddress, "updated_addresses.#", "0"),
+					resource.TestCheckResourceAttr(resourceAddress, "destroyed_addresses.#", "0"),
+				),
+			},
+			{
+				Config: testAccTFEPlanJSONDataSourceConfig(fmt.Sprintf(`workspace_id = "%s"`, parentWorkspace.ID)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceAddress, "run_id", run.ID),
+					resource.TestCheckTypeSetElemAttr(resourceAddress, "created_addresses.*", "random_pet.always_new"),
+				),
+			},
+			{
+				Config:      testAccTFEPlanJSONDataSourceConfig(fmt.Sprintf(`run_id = "%s"`+"\n"+`workspace_id = "%s"`, run.ID, parentWorkspace.ID)),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func testAccTFEPlanJSONDataSourceConfig(selector string) string {
+	return fmt.Sprintf(`
+data "tfe_plan_json" "test" {
+  %s
+}`, selector)
+}
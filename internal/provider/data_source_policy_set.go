@@ -9,10 +9,11 @@
 package provider
 
 import (
+	"context"
 	"errors"
-	"fmt"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -20,7 +21,7 @@ func dataSourceTFEPolicySet() *schema.Resource {
 	return &schema.Resource{
 		Description: "Gets a policy set defined in a specified organization.",
 
-		Read: dataSourceTFEPolicySetRead,
+		ReadWithoutTimeout: dataSourceTFEPolicySetRead,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -160,13 +161,13 @@ func dataSourceTFEPolicySet() *schema.Resource {
 	}
 }
 
-func dataSourceTFEPolicySetRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTFEPolicySetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	name := d.Get("name").(string)
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	listOptions := tfe.PolicySetListOptions{}
@@ -176,9 +177,9 @@ func dataSourceTFEPolicySetRead(d *schema.ResourceData, meta interface{}) error
 
 		if err != nil {
 			if errors.Is(err, tfe.ErrResourceNotFound) {
-				return fmt.Errorf("could not find policy set %s/%s", organization, name)
+				return diag.Errorf("could not find policy set %s/%s", organization, name)
 			}
-			return fmt.Errorf("Error retrieving policy set %s: %w", name, err)
+			return diag.Errorf("Error retrieving policy set %s: %s", name, err)
 		}
 
 		for _, policySet := range policySetList.Items {
@@ -256,5 +257,5 @@ func dataSourceTFEPolicySetRead(d *schema.ResourceData, meta interface{}) error
 		// Update the page number to get the next page.
 		listOptions.PageNumber = policySetList.NextPage
 	}
-	return fmt.Errorf("could not find policy set %s/%s", organization, name)
+	return diag.Errorf("could not find policy set %s/%s", organization, name)
 }
@@ -0,0 +1,317 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &dataSourceTFERun{}
+	_ datasource.DataSourceWithConfigure = &dataSourceTFERun{}
+)
+
+// runDataSourceIncludes are the relations read along with the runs of the
+// tfe_run and tfe_runs data sources.
+var runDataSourceIncludes = []tfe.RunIncludeOpt{
+	tfe.RunPlan,
+	tfe.RunConfigVerIngress,
+}
+
+// NewRunDataSource is a helper function to simplify the provider implementation.
+func NewRunDataSource() datasource.DataSource {
+	return &dataSourceTFERun{}
+}
+
+// modelTFERun maps the data source schema data of a run to a struct.
+type modelTFERun struct {
+	ID                   types.String `tfsdk:"id"`
+	WorkspaceID          types.String `tfsdk:"workspace_id"`
+	Status               types.String `tfsdk:"status"`
+	StatusTimestamps     types.Map    `tfsdk:"status_timestamps"`
+	Message              types.String `tfsdk:"message"`
+	Source               types.String `tfsdk:"source"`
+	TriggerReason        types.String `tfsdk:"trigger_reason"`
+	IsDestroy            types.Bool   `tfsdk:"is_destroy"`
+	PlanOnly             types.Bool   `tfsdk:"plan_only"`
+	RefreshOnly          types.Bool   `tfsdk:"refresh_only"`
+	HasChanges           types.Bool   `tfsdk:"has_changes"`
+	CreatedAt            types.String `tfsdk:"created_at"`
+	CreatedBy            types.String `tfsdk:"created_by"`
+	ConfirmedBy          types.String `tfsdk:"confirmed_by"`
+	CommitSHA            types.String `tfsdk:"commit_sha"`
+	CommitURL            types.String `tfsdk:"commit_url"`
+	Branch               types.String `tfsdk:"branch"`
+	ResourceAdditions    types.Int64  `tfsdk:"resource_additions"`
+	ResourceChanges      types.Int64  `tfsdk:"resource_changes"`
+	ResourceDestructions types.Int64  `tfsdk:"resource_destructions"`
+	ResourceImports      types.Int64  `tfsdk:"resource_imports"`
+}
+
+// modelFromTFERun builds a modelTFERun struct from a run read with the
+// runDataSourceIncludes.
+func modelFromTFERun(workspaceID string, run *tfe.Run) modelTFERun {
+	result := modelTFERun{
+		ID:                   types.StringValue(run.ID),
+		WorkspaceID:          types.StringValue(workspaceID),
+		Status:               types.StringValue(string(run.Status)),
+		StatusTimestamps:     runStatusTimestampsValue(run.StatusTimestamps),
+		Message:              types.StringValue(run.Message),
+		Source:               types.StringValue(string(run.Source)),
+		TriggerReason:        stringValueOrNull(run.TriggerReason),
+		IsDestroy:            types.BoolValue(run.IsDestroy),
+		PlanOnly:             types.BoolValue(run.PlanOnly),
+		RefreshOnly:          types.BoolValue(run.RefreshOnly),
+		HasChanges:           types.BoolValue(run.HasChanges),
+		CreatedAt:            timeStringOrNull(run.CreatedAt),
+		CreatedBy:            types.StringNull(),
+		ConfirmedBy:          types.StringNull(),
+		CommitSHA:            types.StringNull(),
+		CommitURL:            types.StringNull(),
+		Branch:               types.StringNull(),
+		ResourceAdditions:    types.Int64Null(),
+		ResourceChanges:      types.Int64Null(),
+		ResourceDestructions: types.Int64Null(),
+		ResourceImports:      types.Int64Null(),
+	}
+
+	if run.CreatedBy != nil {
+		result.CreatedBy = stringValueOrNull(run.CreatedBy.ID)
+	}
+	if run.ConfirmedBy != nil {
+		result.ConfirmedBy = stringValueOrNull(run.ConfirmedBy.ID)
+	}
+
+	if run.ConfigurationVersion != nil && run.ConfigurationVersion.IngressAttributes != nil {
+		ingress := run.ConfigurationVersion.IngressAttributes
+		result.CommitSHA = stringValueOrNull(ingress.CommitSHA)
+		result.CommitURL = stringValueOrNull(ingress.CommitURL)
+		result.Branch = stringValueOrNull(ingress.Branch)
+	}
+
+	// The resource counts are only known once the plan has finished.
+	if run.Plan != nil && run.Plan.Status == tfe.PlanFinished {
+		result.ResourceAdditions = types.Int64Value(int64(run.Plan.ResourceAdditions))
+		result.ResourceChanges = types.Int64Value(int64(run.Plan.ResourceChanges))
+		result.ResourceDestructions = types.Int64Value(int64(run.Plan.ResourceDestructions))
+		result.ResourceImports = types.Int64Value(int64(run.Plan.ResourceImports))
+	}
+
+	return result
+}
+
+// runStatusTimestampsValue maps the status timestamps the run has reached,
+// such as "applied_at", to their RFC3339 times.
+func runStatusTimestampsValue(timestamps *tfe.RunStatusTimestamps) types.Map {
+	values := map[string]attr.Value{}
+	if timestamps == nil {
+		return types.MapValueMust(types.StringType, values)
+	}
+
+	for name, t := range map[string]time.Time{
+		"applied_at":              timestamps.AppliedAt,
+		"applying_at":             timestamps.ApplyingAt,
+		"apply_queued_at":         timestamps.ApplyQueuedAt,
+		"canceled_at":             timestamps.CanceledAt,
+		"confirmed_at":            timestamps.ConfirmedAt,
+		"cost_estimated_at":       timestamps.CostEstimatedAt,
+		"cost_estimating_at":      timestamps.CostEstimatingAt,
+		"discarded_at":            timestamps.DiscardedAt,
+		"errored_at":              timestamps.ErroredAt,
+		"fetched_at":              timestamps.FetchedAt,
+		"fetching_at":             timestamps.FetchingAt,
+		"force_canceled_at":       timestamps.ForceCanceledAt,
+		"planned_and_finished_at": timestamps.PlannedAndFinishedAt,
+		"planned_and_saved_at":    timestamps.PlannedAndSavedAt,
+		"planned_at":              timestamps.PlannedAt,
+		"planning_at":             timestamps.PlanningAt,
+		"plan_queueable_at":       timestamps.PlanQueueableAt,
+		"plan_queued_at":          timestamps.PlanQueuedAt,
+		"policy_checked_at":       timestamps.PolicyCheckedAt,
+		"policy_soft_failed_at":   timestamps.PolicySoftFailedAt,
+		"post_plan_completed_at":  timestamps.PostPlanCompletedAt,
+		"post_plan_running_at":    timestamps.PostPlanRunningAt,
+		"pre_plan_completed_at":   timestamps.PrePlanCompletedAt,
+		"pre_plan_running_at":     timestamps.PrePlanRunningAt,
+		"queuing_at":              timestamps.QueuingAt,
+	} {
+		if !t.IsZero() {
+			values[name] = types.StringValue(t.Format(time.RFC3339))
+		}
+	}
+	return types.MapValueMust(types.StringType, values)
+}
+
+// runDataSourceAttributes are the computed attributes describing a run, shared
+// by the tfe_run data source and the runs of the tfe_runs data source.
+func runDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"workspace_id": schema.StringAttribute{
+			Description: "ID of the workspace of the run.",
+			Computed:    true,
+		},
+		"status": schema.StringAttribute{
+			Description: "Current status of the run, such as `applied` or `errored`.",
+			Computed:    true,
+		},
+		"status_timestamps": schema.MapAttribute{
+			Description: "Times the run reached each of its statuses, in RFC3339 format, keyed by names such as `plan_queued_at`, `applied_at` and `errored_at`. Only the statuses the run has reached are present.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"message": schema.StringAttribute{
+			Description: "Message of the run.",
+			Computed:    true,
+		},
+		"source": schema.StringAttribute{
+			Description: "Source of the run, such as `tfe-api`, `tfe-ui` or `tfe-configuration-version`.",
+			Computed:    true,
+		},
+		"trigger_reason": schema.StringAttribute{
+			Description: "Reason the run was triggered, such as `manual` or `vcs`.",
+			Computed:    true,
+		},
+		"is_destroy": schema.BoolAttribute{
+			Description: "Whether the run destroys all the resources of the workspace.",
+			Computed:    true,
+		},
+		"plan_only": schema.BoolAttribute{
+			Description: "Whether the run is a speculative plan that cannot be applied.",
+			Computed:    true,
+		},
+		"refresh_only": schema.BoolAttribute{
+			Description: "Whether the run only refreshes the state.",
+			Computed:    true,
+		},
+		"has_changes": schema.BoolAttribute{
+			Description: "Whether the plan of the run has changes.",
+			Computed:    true,
+		},
+		"created_at": schema.StringAttribute{
+			Description: "Time the run was created, in RFC3339 format.",
+			Computed:    true,
+		},
+		"created_by": schema.StringAttribute{
+			Description: "ID of the user who created the run.",
+			Computed:    true,
+		},
+		"confirmed_by": schema.StringAttribute{
+			Description: "ID of the user who confirmed the run. Unset for runs that were applied automatically or not confirmed.",
+			Computed:    true,
+		},
+		"commit_sha": schema.StringAttribute{
+			Description: "SHA of the VCS commit the run was triggered from. Unset for runs that were not triggered from a VCS repository.",
+			Computed:    true,
+		},
+		"commit_url": schema.StringAttribute{
+			Description: "URL of the VCS commit the run was triggered from.",
+			Computed:    true,
+		},
+		"branch": schema.StringAttribute{
+			Description: "VCS branch the run was triggered from.",
+			Computed:    true,
+		},
+		"resource_additions": schema.Int64Attribute{
+			Description: "Number of resources the plan of the run adds. Unset until the plan has finished.",
+			Computed:    true,
+		},
+		"resource_changes": schema.Int64Attribute{
+			Description: "Number of resources the plan of the run changes. Unset until the plan has finished.",
+			Computed:    true,
+		},
+		"resource_destructions": schema.Int64Attribute{
+			Description: "Number of resources the plan of the run destroys. Unset until the plan has finished.",
+			Computed:    true,
+		},
+		"resource_imports": schema.Int64Attribute{
+			Description: "Number of resources the plan of the run imports. Unset until the plan has finished.",
+			Computed:    true,
+		},
+	}
+}
+
+// dataSourceTFERun is the data source implementation.
+type dataSourceTFERun struct {
+	config ConfiguredClient
+}
+
+// Metadata returns the data source type name.
+func (d *dataSourceTFERun) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_run"
+}
+
+// Schema defines the schema for the data source.
+func (d *dataSourceTFERun) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := runDataSourceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Description: "ID of the run.",
+		Required:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Gets information on a run, such as its status timestamps, the VCS commit it was triggered from and the resource counts of its plan.",
+		Attributes:  attributes,
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *dataSourceTFERun) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ConfiguredClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected tfe.ConfiguredClient, got %T. This is a bug in the tfe provider, so please report it on GitHub.", req.ProviderData),
+		)
+
+		return
+	}
+	d.config = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dataSourceTFERun) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var id types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("id"), &id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading run", map[string]any{"run_id": id.ValueString()})
+	run, err := d.config.Client.Runs.ReadWithOptions(ctx, id.ValueString(), &tfe.RunReadOptions{
+		Include: runDataSourceIncludes,
+	})
+	if err != nil {
+		if errors.Is(err, tfe.ErrResourceNotFound) {
+			resp.Diagnostics.AddError("Run not found", fmt.Sprintf("Could not find run %s", id.ValueString()))
+			return
+		}
+		resp.Diagnostics.AddError("Unable to read run", fmt.Sprintf("Error reading run %s: %s", id.ValueString(), err))
+		return
+	}
+
+	workspaceID := ""
+	if run.Workspace != nil {
+		workspaceID = run.Workspace.ID
+	}
+	model := modelFromTFERun(workspaceID, run)
+
+	// Save model into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
@@ -0,0 +1,113 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"math/rand"
+	"regexp"
+	"testing"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestModelFromTFERun(t *testing.T) {
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	run := &tfe.Run{
+		ID:        "run-01",
+		Status:    tfe.RunApplied,
+		Message:   "Merge pull request",
+		Source:    tfe.RunSourceConfigurationVersion,
+		CreatedAt: created,
+		StatusTimestamps: &tfe.RunStatusTimestamps{
+			PlanQueuedAt: created,
+			AppliedAt:    created.Add(time.Minute),
+		},
+		ConfirmedBy: &tfe.User{ID: "user-01"},
+		ConfigurationVersion: &tfe.ConfigurationVersion{
+			IngressAttributes: &tfe.IngressAttributes{CommitSHA: "abc123", Branch: "main"},
+		},
+		Plan: &tfe.Plan{Status: tfe.PlanFinished, ResourceAdditions: 2, ResourceDestructions: 1},
+	}
+
+	model := modelFromTFERun("ws-01", run)
+
+	if model.WorkspaceID.ValueString() != "ws-01" || model.Status.ValueString() != "applied" {
+		t.Fatalf("unexpected workspace or status: %s, %s", model.WorkspaceID, model.Status)
+	}
+	if model.CreatedAt.ValueString() != "2026-01-02T03:04:05Z" {
+		t.Fatalf("unexpected created_at: %s", model.CreatedAt)
+	}
+	timestamps := model.StatusTimestamps.Elements()
+	if len(timestamps) != 2 || timestamps["applied_at"].String() != `"2026-01-02T03:05:05Z"` {
+		t.Fatalf("unexpected status_timestamps: %s", model.StatusTimestamps)
+	}
+	if model.ConfirmedBy.ValueString() != "user-01" || !model.CreatedBy.IsNull() {
+		t.Fatalf("unexpected confirmed_by or created_by: %s, %s", model.ConfirmedBy, model.CreatedBy)
+	}
+	if model.CommitSHA.ValueString() != "abc123" || model.Branch.ValueString() != "main" || !model.CommitURL.IsNull() {
+		t.Fatalf("unexpected VCS commit: %s, %s, %s", model.CommitSHA, model.Branch, model.CommitURL)
+	}
+	if model.ResourceAdditions.ValueInt64() != 2 || model.ResourceDestructions.ValueInt64() != 1 || model.ResourceChanges.ValueInt64() != 0 {
+		t.Fatalf("unexpected resource counts: %s, %s, %s", model.ResourceAdditions, model.ResourceChanges, model.ResourceDestructions)
+	}
+
+	run.Plan.Status = tfe.PlanRunning
+	if model := modelFromTFERun("ws-01", run); !model.ResourceAdditions.IsNull() {
+		t.Fatalf("expected no resource counts before the plan finished, got %s", model.ResourceAdditions)
+	}
+}
+
+func TestAccTFERunDataSource_basic(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	organization, orgCleanup := createOrganization(t, tfeClient, tfe.OrganizationCreateOptions{
+		Name:  tfe.String(fmt.Sprintf("tst-org-%d", rInt)),
+		Email: tfe.String("admin@terraformer.inc"),
+	})
+	t.Cleanup(orgCleanup)
+
+	parentWorkspace, _ := setupWorkspacesWithConfig(t, tfeClient, rInt, organization.Name, "test-fixtures/basic-config")
+	run := createAppliedTFERun(t, tfeClient, parentWorkspace)
+	resourceAddress := "data.tfe_run.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFERunDataSourceConfig(run.ID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceAddress, "id", run.ID),
+					resource.TestCheckResourceAttr(resourceAddress, "workspace_id", parentWorkspace.ID),
+					resource.TestCheckResourceAttr(resourceAddress, "status", "applied"),
+					resource.TestCheckResourceAttr(resourceAddress, "plan_only", "false"),
+					resource.TestCheckResourceAttr(resourceAddress, "has_changes", "true"),
+					resource.TestCheckResourceAttrSet(resourceAddress, "status_timestamps.plan_queued_at"),
+					resource.TestCheckResourceAttrSet(resourceAddress, "status_timestamps.applied_at"),
+					resource.TestCheckResourceAttr(resourceAddress, "resource_additions", "1"),
+					resource.TestCheckNoResourceAttr(resourceAddress, "commit_sha"),
+				),
+			},
+			{
+				Config:      testAccTFERunDataSourceConfig("run-doesnotexist"),
+				ExpectError: regexp.MustCompile(`Run not found`),
+			},
+		},
+	})
+}
+
+func testAccTFERunDataSourceConfig(runID string) string {
+	return fmt.Sprintf(`
+data "tfe_run" "test" {
+  id = "%s"
+}`, runID)
+}
@@ -0,0 +1,271 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &dataSourceTFERuns{}
+	_ datasource.DataSourceWithConfigure = &dataSourceTFERuns{}
+)
+
+// NewRunsDataSource is a helper function to simplify the provider implementation.
+func NewRunsDataSource() datasource.DataSource {
+	return &dataSourceTFERuns{}
+}
+
+// dataSourceTFERuns is the data source implementation.
+type dataSourceTFERuns struct {
+	config ConfiguredClient
+}
+
+// modelTFERuns maps the data source schema data.
+type modelTFERuns struct {
+	ID           types.String      `tfsdk:"id"`
+	WorkspaceID  types.String      `tfsdk:"workspace_id"`
+	Statuses     types.Set         `tfsdk:"statuses"`
+	Operations   types.Set         `tfsdk:"operations"`
+	Sources      types.Set         `tfsdk:"sources"`
+	CommitSHA    types.String      `tfsdk:"commit_sha"`
+	CreatedAfter timetypes.RFC3339 `tfsdk:"created_after"`
+	MaxResults   types.Int64       `tfsdk:"max_results"`
+	Runs         []modelTFERun     `tfsdk:"runs"`
+}
+
+// defaultRunsMaxResults is the number of runs listed when max_results is not
+// set, so that workspaces with a long history are not listed in full.
+const defaultRunsMaxResults = 100
+
+// runsFilter selects the runs of a workspace. The statuses, operations,
+// sources and commit are filtered by the API, the creation time and the
+// number of runs by the provider.
+type runsFilter struct {
+	statuses     []string
+	operations   []string
+	sources      []string
+	commitSHA    string
+	createdAfter time.Time
+
+	// maxResults is the maximum number of runs to list, or 0 to list them
+	// all.
+	maxResults int
+}
+
+// Metadata returns the data source type name.
+func (d *dataSourceTFERuns) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_runs"
+}
+
+// Schema defines the schema for the data source.
+func (d *dataSourceTFERuns) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	runAttributes := runDataSourceAttributes()
+	runAttributes["id"] = schema.StringAttribute{
+		Description: "ID of the run.",
+		Computed:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Gets information on the runs of a workspace, newest first, optionally filtered by status, operation, source, VCS commit and creation time.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the workspace for use as an ID.",
+				Computed:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				Description: "ID of the workspace to list the runs of.",
+				Required:    true,
+			},
+			"statuses": schema.SetAttribute{
+				Description: "Only list the runs with one of these statuses, such as `applied` or `planned_and_finished`.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(allRunStatusValues()...)),
+				},
+			},
+			"operations": schema.SetAttribute{
+				Description: "Only list the runs with one of these operations: `plan_and_apply`, `plan_only`, `refresh_only`, `destroy`, `empty_apply` or `save_plan`.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(
+						string(tfe.RunOperationPlanApply),
+						string(tfe.RunOperationPlanOnly),
+						string(tfe.RunOperationRefreshOnly),
+						string(tfe.RunOperationDestroy),
+						string(tfe.RunOperationEmptyApply),
+						string(tfe.RunOperationSavePlan),
+					)),
+				},
+			},
+			"sources": schema.SetAttribute{
+				Description: "Only list the runs with one of these sources, such as `tfe-api`, `tfe-ui` or `tfe-configuration-version`.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"commit_sha": schema.StringAttribute{
+				Description: "Only list the runs triggered from this VCS commit.",
+				Optional:    true,
+			},
+			"created_after": schema.StringAttribute{
+				Description: "Only list the runs created after this time, in RFC3339 format, such as `2026-01-02T15:04:05Z`.",
+				CustomType:  timetypes.RFC3339Type{},
+				Optional:    true,
+			},
+			"max_results": schema.Int64Attribute{
+				Description: "The maximum number of runs to list, newest first. Defaults to `100`.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"runs": schema.ListNestedAttribute{
+				Description: "List of the runs of the workspace, newest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: runAttributes,
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *dataSourceTFERuns) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ConfiguredClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected tfe.ConfiguredClient, got %T. This is a bug in the tfe provider, so please report it on GitHub.", req.ProviderData),
+		)
+
+		return
+	}
+	d.config = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dataSourceTFERuns) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model modelTFERuns
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var filter runsFilter
+	resp.Diagnostics.Append(model.Statuses.ElementsAs(ctx, &filter.statuses, true)...)
+	resp.Diagnostics.Append(model.Operations.ElementsAs(ctx, &filter.operations, true)...)
+	resp.Diagnostics.Append(model.Sources.ElementsAs(ctx, &filter.sources, true)...)
+	filter.commitSHA = model.CommitSHA.ValueString()
+	if !model.CreatedAfter.IsNull() {
+		createdAfter, diags := model.CreatedAfter.ValueRFC3339Time()
+		resp.Diagnostics.Append(diags...)
+		filter.createdAfter = createdAfter
+	}
+	filter.maxResults = defaultRunsMaxResults
+	if !model.MaxResults.IsNull() {
+		filter.maxResults = int(model.MaxResults.ValueInt64())
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID := model.WorkspaceID.ValueString()
+	runs, err := listRuns(ctx, d.config.Client, workspaceID, filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to list runs", err.Error())
+		return
+	}
+
+	model.ID = types.StringValue(workspaceID)
+	model.Runs = make([]modelTFERun, 0, len(runs))
+	for _, run := range runs {
+		model.Runs = append(model.Runs, modelFromTFERun(workspaceID, run))
+	}
+
+	// Save model into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// allRunStatusValues are the statuses of all runs, finished or not.
+func allRunStatusValues() []string {
+	return append(runStatusValues(),
+		string(tfe.RunApplied),
+		string(tfe.RunCanceled),
+		string(tfe.RunDiscarded),
+		string(tfe.RunErrored),
+		string(tfe.RunPlannedAndFinished),
+		string(tfe.RunPlannedAndSaved),
+	)
+}
+
+// listRuns lists the runs of the workspace matching the filter, newest first.
+// As the runs are listed newest first, it stops at the first run created
+// before the createdAfter time of the filter, or once it has listed
+// maxResults runs.
+func listRuns(ctx context.Context, tfeClient *tfe.Client, workspaceID string, filter runsFilter) ([]*tfe.Run, error) {
+	options := &tfe.RunListOptions{
+		Status:    strings.Join(filter.statuses, ","),
+		Operation: strings.Join(filter.operations, ","),
+		Source:    strings.Join(filter.sources, ","),
+		Commit:    filter.commitSHA,
+		Include:   runDataSourceIncludes,
+	}
+
+	runs := []*tfe.Run{}
+	for {
+		tflog.Debug(ctx, "Listing runs", map[string]any{"workspace_id": workspaceID, "page": options.PageNumber})
+		rl, err := tfeClient.Runs.List(ctx, workspaceID, options)
+		if err != nil {
+			return nil, fmt.Errorf("error listing runs of workspace %s: %w", workspaceID, err)
+		}
+
+		for _, run := range rl.Items {
+			if !filter.createdAfter.IsZero() && !run.CreatedAt.After(filter.createdAfter) {
+				return runs, nil
+			}
+			runs = append(runs, run)
+			if filter.maxResults > 0 && len(runs) >= filter.maxResults {
+				return runs, nil
+			}
+		}
+
+		// Exit the loop when we've seen all pages.
+		if rl.Pagination == nil || rl.CurrentPage >= rl.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = rl.NextPage
+	}
+	return runs, nil
+}
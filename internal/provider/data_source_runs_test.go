@@ -0,0 +1,242 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	tfemocks "github.com/hashicorp/go-tfe/mocks"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListRuns(t *testing.T) {
+	now := time.Now()
+
+	t.Run("filters", func(t *testing.T) {
+		client := testTfeClient(t, testClientOptions{})
+		ctrl := gomock.NewController(t)
+		mockRunsAPI := tfemocks.NewMockRuns(ctrl)
+		mockRunsAPI.
+			EXPECT().
+			List(gomock.Any(), "ws-01", &tfe.RunListOptions{
+				Status:    "applied,errored",
+				Operation: "plan_and_apply",
+				Source:    "tfe-api",
+				Commit:    "abc123",
+				Include:   runDataSourceIncludes,
+			}).
+			Return(&tfe.RunList{
+				Pagination: &tfe.Pagination{CurrentPage: 1, TotalPages: 1},
+				Items:      []*tfe.Run{{ID: "run-01", CreatedAt: now}},
+			}, nil)
+		client.Runs = mockRunsAPI
+
+		runs, err := listRuns(context.Background(), client, "ws-01", runsFilter{
+			statuses:   []string{"applied", "errored"},
+			operations: []string{"plan_and_apply"},
+			sources:    []string{"tfe-api"},
+			commitSHA:  "abc123",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if len(runs) != 1 || runs[0].ID != "run-01" {
+			t.Fatalf("expected run-01, got %v", runs)
+		}
+	})
+
+	t.Run("stops at the first run created before created_after", func(t *testing.T) {
+		client := testTfeClient(t, testClientOptions{})
+		ctrl := gomock.NewController(t)
+		mockRunsAPI := tfemocks.NewMockRuns(ctrl)
+		// Only the first page is read, as its last run is too old.
+		mockRunsAPI.
+			EXPECT().
+			List(gomock.Any(), "ws-01", &tfe.RunListOptions{Include: runDataSourceIncludes}).
+			Return(&tfe.RunList{
+				Pagination: &tfe.Pagination{CurrentPage: 1, NextPage: 2, TotalPages: 2},
+				Items: []*tfe.Run{
+					{ID: "run-new", CreatedAt: now.Add(-time.Hour)},
+					{ID: "run-old", CreatedAt: now.Add(-48 * time.Hour)},
+				},
+			}, nil)
+		client.Runs = mockRunsAPI
+
+		runs, err := listRuns(context.Background(), client, "ws-01", runsFilter{createdAfter: now.Add(-24 * time.Hour)})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if len(runs) != 1 || runs[0].ID != "run-new" {
+			t.Fatalf("expected only run-new, got %v", runs)
+		}
+	})
+
+	t.Run("stops at max_results", func(t *testing.T) {
+		client := testTfeClient(t, testClientOptions{})
+		ctrl := gomock.NewController(t)
+		mockRunsAPI := tfemocks.NewMockRuns(ctrl)
+		// Only the first page is read, as it holds enough runs.
+		mockRunsAPI.
+			EXPECT().
+			List(gomock.Any(), "ws-01", gomock.Any()).
+			Return(&tfe.RunList{
+				Pagination: &tfe.Pagination{CurrentPage: 1, NextPage: 2, TotalPages: 2},
+				Items: []*tfe.Run{
+					{ID: "run-03", CreatedAt: now},
+					{ID: "run-02", CreatedAt: now.Add(-time.Hour)},
+				},
+			}, nil)
+		client.Runs = mockRunsAPI
+
+		runs, err := listRuns(context.Background(), client, "ws-01", runsFilter{maxResults: 1})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if len(runs) != 1 || runs[0].ID != "run-03" {
+			t.Fatalf("expected only run-03, got %v", runs)
+		}
+	})
+
+	t.Run("paginates", func(t *testing.T) {
+		client := testTfeClient(t, testClientOptions{})
+		ctrl := gomock.NewController(t)
+		mockRunsAPI := tfemocks.NewMockRuns(ctrl)
+		gomock.InOrder(
+			mockRunsAPI.
+				EXPECT().
+				List(gomock.Any(), "ws-01", gomock.Any()).
+				Return(&tfe.RunList{
+					Pagination: &tfe.Pagination{CurrentPage: 1, NextPage: 2, TotalPages: 2},
+					Items:      []*tfe.Run{{ID: "run-02", CreatedAt: now}},
+				}, nil),
+			mockRunsAPI.
+				EXPECT().
+				List(gomock.Any(), "ws-01", gomock.Any()).
+				Return(&tfe.RunList{
+					Pagination: &tfe.Pagination{CurrentPage: 2, TotalPages: 2},
+					Items:      []*tfe.Run{{ID: "run-01", CreatedAt: now.Add(-time.Hour)}},
+				}, nil),
+		)
+		client.Runs = mockRunsAPI
+
+		runs, err := listRuns(context.Background(), client, "ws-01", runsFilter{})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if len(runs) != 2 || runs[0].ID != "run-02" || runs[1].ID != "run-01" {
+			t.Fatalf("expected run-02 and run-01, got %v", runs)
+		}
+	})
+}
+
+func TestRunsDataSource_statusesValidation(t *testing.T) {
+	ctx := context.Background()
+	var resp datasource.SchemaResponse
+	NewRunsDataSource().Schema(ctx, datasource.SchemaRequest{}, &resp)
+	statuses := resp.Schema.Attributes["statuses"].(schema.SetAttribute)
+
+	validate := func(status string) diag.Diagnostics {
+		value, diags := types.SetValueFrom(ctx, types.StringType, []string{status})
+		for _, v := range statuses.Validators {
+			validateResp := &validator.SetResponse{}
+			v.ValidateSet(ctx, validator.SetRequest{Path: path.Root("statuses"), ConfigValue: value}, validateResp)
+			diags.Append(validateResp.Diagnostics...)
+		}
+		return diags
+	}
+
+	for _, status := range []string{"applied", "errored", "planned_and_finished", "planning"} {
+		if diags := validate(status); diags.HasError() {
+			t.Errorf("expected status %s to be valid, got %v", status, diags)
+		}
+	}
+	if diags := validate("finished"); !diags.HasError() {
+		t.Error("expected status finished to be invalid")
+	}
+}
+
+func TestAccTFERunsDataSource_basic(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	organization, orgCleanup := createOrganization(t, tfeClient, tfe.OrganizationCreateOptions{
+		Name:  tfe.String(fmt.Sprintf("tst-org-%d", rInt)),
+		Email: tfe.String("admin@terraformer.inc"),
+	})
+	t.Cleanup(orgCleanup)
+
+	parentWorkspace, _ := setupWorkspacesWithConfig(t, tfeClient, rInt, organization.Name, "test-fixtures/basic-config")
+	run := createAppliedTFERun(t, tfeClient, parentWorkspace)
+	resourceAddress := "data.tfe_runs.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFERunsDataSourceConfig(parentWorkspace.ID, `statuses = ["applied"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceAddress, "id", parentWorkspace.ID),
+					resource.TestCheckResourceAttr(resourceAddress, "runs.#", "1"),
+					resource.TestCheckResourceAttr(resourceAddress, "runs.0.id", run.ID),
+					resource.TestCheckResourceAttr(resourceAddress, "runs.0.workspace_id", parentWorkspace.ID),
+					resource.TestCheckResourceAttr(resourceAddress, "runs.0.status", "applied"),
+					resource.TestCheckResourceAttr(resourceAddress, "runs.0.message", "Created by the tfe_runs data source test"),
+					resource.TestCheckResourceAttr(resourceAddress, "runs.0.source", "tfe-api"),
+					resource.TestCheckResourceAttr(resourceAddress, "runs.0.is_destroy", "false"),
+					resource.TestCheckResourceAttrSet(resourceAddress, "runs.0.created_at"),
+					resource.TestCheckResourceAttrSet(resourceAddress, "runs.0.status_timestamps.applied_at"),
+					resource.TestCheckResourceAttrSet(resourceAddress, "runs.0.created_by"),
+					resource.TestCheckResourceAttr(resourceAddress, "runs.0.resource_additions", "1"),
+					resource.TestCheckResourceAttr(resourceAddress, "runs.0.resource_destructions", "0"),
+				),
+			},
+			{
+				Config: testAccTFERunsDataSourceConfig(parentWorkspace.ID, `statuses = ["errored"]`),
+				Check:  resource.TestCheckResourceAttr(resourceAddress, "runs.#", "0"),
+			},
+			{
+				Config: testAccTFERunsDataSourceConfig(parentWorkspace.ID, fmt.Sprintf(`created_after = "%s"`, time.Now().Add(time.Hour).UTC().Format(time.RFC3339))),
+				Check:  resource.TestCheckResourceAttr(resourceAddress, "runs.#", "0"),
+			},
+		},
+	})
+}
+
+func createAppliedTFERun(t *testing.T, client *tfe.Client, ws *tfe.Workspace) *tfe.Run {
+	run, err := client.Runs.Create(context.Background(), tfe.RunCreateOptions{
+		Workspace: ws,
+		Message:   tfe.String("Created by the tfe_runs data source test"),
+		AutoApply: tfe.Bool(true),
+	})
+	if err != nil {
+		t.Fatalf("Error creating run: %s", err)
+	}
+	awaitTFERunStatus(t, client, run.ID, tfe.RunApplied)
+	return run
+}
+
+func testAccTFERunsDataSourceConfig(workspaceID, filter string) string {
+	return fmt.Sprintf(`
+data "tfe_runs" "test" {
+  workspace_id = "%s"
+  %s
+}`, workspaceID, filter)
+}
@@ -10,6 +10,7 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
@@ -17,6 +18,7 @@ import (
 	"os"
 
 	slug "github.com/hashicorp/go-slug"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -25,7 +27,7 @@ func dataSourceTFESlug() *schema.Resource {
 		Description: "Represents configuration files on a local filesystem intended to be uploaded to HCP Terraform or Terraform Enterprise, in lieu of those files being sourced from a configured VCS provider." +
 			"\n\nA unique checksum is generated for the specific local directory, which allows resources such as `tfe_policy_set` to track the files and upload a new gzip compressed tar file containing configuration files (a Terraform \"slug\") when those files change.",
 
-		Read: dataSourceTFESlugRead,
+		ReadWithoutTimeout: dataSourceTFESlugRead,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -43,13 +45,13 @@ func dataSourceTFESlug() *schema.Resource {
 	}
 }
 
-func dataSourceTFESlugRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTFESlugRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sourcePath := d.Get("source_path").(string)
 
 	log.Printf("[DEBUG] Hashing the source path files: %s", sourcePath)
 	chksum, err := hashPolicies(sourcePath)
 	if err != nil {
-		return fmt.Errorf("Error generating the checksum for the source path files: %w", err)
+		return diag.Errorf("Error generating the checksum for the source path files: %s", err)
 	}
 	d.SetId(chksum)
 
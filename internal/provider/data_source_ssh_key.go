@@ -9,9 +9,10 @@
 package provider
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/hashicorp/go-tfe/v2/api/organizations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -19,7 +20,7 @@ func dataSourceTFESSHKey() *schema.Resource {
 	return &schema.Resource{
 		Description: "Gets information on an SSH key.",
 
-		Read: dataSourceTFESSHKeyRead,
+		ReadWithoutTimeout: dataSourceTFESSHKeyRead,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -43,14 +44,14 @@ func dataSourceTFESSHKey() *schema.Resource {
 	}
 }
 
-func dataSourceTFESSHKeyRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTFESSHKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the name and organization.
 	name := d.Get("name").(string)
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Create the query parameters.
@@ -62,7 +63,7 @@ func dataSourceTFESSHKeyRead(d *schema.ResourceData, meta interface{}) error {
 	for {
 		l, err := config.ClientV2.API.Organizations().ByOrganization_name(organization).SshKeys().Get(ctx, withQueryParams(queryParams))
 		if err != nil {
-			return fmt.Errorf("Error retrieving SSH keys: %w", err)
+			return diag.Errorf("Error retrieving SSH keys: %s", err)
 		}
 
 		for _, k := range l.GetData() {
@@ -82,5 +83,5 @@ func dataSourceTFESSHKeyRead(d *schema.ResourceData, meta interface{}) error {
 		queryParams.Pagenumber = nextPage
 	}
 
-	return fmt.Errorf("could not find SSH key %s/%s", organization, name)
+	return diag.Errorf("could not find SSH key %s/%s", organization, name)
 }
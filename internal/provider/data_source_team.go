@@ -9,12 +9,13 @@
 package provider
 
 import (
+	"context"
 	"errors"
-	"fmt"
 	"time"
 
 	tfev2 "github.com/hashicorp/go-tfe/v2"
 	"github.com/hashicorp/go-tfe/v2/api/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -22,7 +23,7 @@ func dataSourceTFETeam() *schema.Resource {
 	return &schema.Resource{
 		Description: "Gets information on a team.",
 
-		Read: dataSourceTFETeamRead,
+		ReadWithoutTimeout: dataSourceTFETeamRead,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -71,22 +72,22 @@ func dataSourceTFETeam() *schema.Resource {
 	}
 }
 
-func dataSourceTFETeamRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTFETeamRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the name and organization.
 	name := d.Get("name").(string)
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	team, err := fetchTeamByNameV2(ctx, config.ClientV2.API, organization, name)
+	team, err := config.lookupTeamByName(ctx, organization, name)
 	if err != nil {
 		if errors.Is(err, tfev2.ErrNotFound) {
-			return fmt.Errorf("could not find team %s/%s", organization, name)
+			return diag.Errorf("could not find team %s/%s", organization, name)
 		}
-		return fmt.Errorf("Error retrieving teams: %w", err)
+		return diag.Errorf("Error retrieving teams: %s", err)
 	}
 
 	setTeamResourceData(d, team)
@@ -9,9 +9,10 @@
 package provider
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/hashicorp/go-tfe/v2/api/teamworkspaces"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -19,7 +20,7 @@ func dataSourceTFETeamAccess() *schema.Resource {
 	return &schema.Resource{
 		Description: "Gets information on team permissions on a workspace.",
 
-		Read: dataSourceTFETeamAccessRead,
+		ReadWithoutTimeout: dataSourceTFETeamAccessRead,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -100,7 +101,7 @@ func dataSourceTFETeamAccess() *schema.Resource {
 	}
 }
 
-func dataSourceTFETeamAccessRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTFETeamAccessRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the team ID.
@@ -110,11 +111,11 @@ func dataSourceTFETeamAccessRead(d *schema.ResourceData, meta interface{}) error
 	workspaceID := d.Get("workspace_id").(string)
 	ws, err := config.ClientV2.API.Workspaces().ByWorkspace_id(workspaceID).Get(ctx, nil)
 	if err != nil {
-		return fmt.Errorf(
-			"Error retrieving workspace %s: %w", workspaceID, err)
+		return diag.Errorf(
+			"Error retrieving workspace %s: %s", workspaceID, err)
 	}
 	if ws == nil || ws.GetData() == nil {
-		return fmt.Errorf("Error retrieving workspace %s: no data returned", workspaceID)
+		return diag.Errorf("Error retrieving workspace %s: no data returned", workspaceID)
 	}
 
 	// Filter directly by workspace and team, which uniquely identify at
@@ -127,7 +128,7 @@ func dataSourceTFETeamAccessRead(d *schema.ResourceData, meta interface{}) error
 
 	result, err := teamWorkspacesBuilder.Get(ctx, withQueryParams(queryParams))
 	if err != nil {
-		return fmt.Errorf("Error retrieving team access list: %w", err)
+		return diag.Errorf("Error retrieving team access list: %s", err)
 	}
 
 	items := result.GetData()
@@ -139,7 +140,7 @@ func dataSourceTFETeamAccessRead(d *schema.ResourceData, meta interface{}) error
 			}
 			if valueOrZero(relationships.GetTeam().GetData().GetId()) == teamID {
 				d.SetId(valueOrZero(ta.GetId()))
-				return resourceTFETeamAccessRead(d, meta)
+				return resourceTFETeamAccessRead(ctx, d, meta)
 			}
 		}
 
@@ -155,10 +156,10 @@ func dataSourceTFETeamAccessRead(d *schema.ResourceData, meta interface{}) error
 		}
 		result, err = teamWorkspacesBuilder.Get(ctx, withQueryParams(queryParams))
 		if err != nil {
-			return fmt.Errorf("Error retrieving team access list: %w", err)
+			return diag.Errorf("Error retrieving team access list: %s", err)
 		}
 		items = result.GetData()
 	}
 
-	return fmt.Errorf("could not find team access for %s and workspace %s", teamID, valueOrZero(ws.GetData().GetAttributes().GetName()))
+	return diag.Errorf("could not find team access for %s and workspace %s", teamID, valueOrZero(ws.GetData().GetAttributes().GetName()))
 }
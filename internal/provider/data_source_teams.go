@@ -9,9 +9,10 @@
 package provider
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/hashicorp/go-tfe/v2/api/organizations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -19,7 +20,7 @@ func dataSourceTFETeams() *schema.Resource {
 	return &schema.Resource{
 		Description: "Gets information on teams. The teams returned may be a subset of all teams in an organization based on the permissions of the API token.",
 
-		Read: dataSourceTFETeamsRead,
+		ReadWithoutTimeout: dataSourceTFETeamsRead,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -50,11 +51,11 @@ func dataSourceTFETeams() *schema.Resource {
 	}
 }
 
-func dataSourceTFETeamsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTFETeamsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	teamsBuilder := config.ClientV2.API.Organizations().ByOrganization_name(organization).Teams()
@@ -66,12 +67,12 @@ func dataSourceTFETeamsRead(d *schema.ResourceData, meta interface{}) error {
 
 	result, err := teamsBuilder.Get(ctx, withQueryParams(queryParams))
 	if err != nil {
-		return fmt.Errorf("Error retrieving teams: %w", err)
+		return diag.Errorf("Error retrieving teams: %s", err)
 	}
 
 	items := result.GetData()
 	if len(items) == 0 {
-		return fmt.Errorf("could not find teams in %q", organization)
+		return diag.Errorf("could not find teams in %q", organization)
 	}
 
 	names := []string{}
@@ -98,7 +99,7 @@ func dataSourceTFETeamsRead(d *schema.ResourceData, meta interface{}) error {
 		}
 		result, err = teamsBuilder.Get(ctx, withQueryParams(queryParams))
 		if err != nil {
-			return fmt.Errorf("Error retrieving teams: %w", err)
+			return diag.Errorf("Error retrieving teams: %s", err)
 		}
 		items = result.GetData()
 	}
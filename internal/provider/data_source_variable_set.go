@@ -9,10 +9,11 @@
 package provider
 
 import (
-	"fmt"
+	"context"
 	"log"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -20,7 +21,7 @@ func dataSourceTFEVariableSet() *schema.Resource {
 	return &schema.Resource{
 		Description: "Gets information on a named variable set.",
 
-		Read: dataSourceTFEVariableSetRead,
+		ReadWithoutTimeout: dataSourceTFEVariableSetRead,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -101,14 +102,14 @@ func dataSourceTFEVariableSet() *schema.Resource {
 	}
 }
 
-func dataSourceTFEVariableSetRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTFEVariableSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the name and organization.
 	name := d.Get("name").(string)
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Create an options struct.
@@ -120,9 +121,9 @@ func dataSourceTFEVariableSetRead(d *schema.ResourceData, meta interface{}) erro
 		l, err := config.Client.VariableSets.List(ctx, organization, &options)
 		if err != nil {
 			if err == tfe.ErrResourceNotFound {
-				return fmt.Errorf("could not find variable set%s/%s", organization, name)
+				return diag.Errorf("could not find variable set%s/%s", organization, name)
 			}
-			return fmt.Errorf("Error retrieving variable set: %w", err)
+			return diag.Errorf("Error retrieving variable set: %s", err)
 		}
 
 		for _, vs := range l.Items {
@@ -139,7 +140,7 @@ func dataSourceTFEVariableSetRead(d *schema.ResourceData, meta interface{}) erro
 				includes := []tfe.VariableSetIncludeOpt{tfe.VariableSetWorkspaces, tfe.VariableSetVars}
 				if meetsMinVersionRequirement, err := config.MeetsMinRemoteTFEVersion(minTFEVersionVariableSetStacks); err != nil {
 					log.Printf("[DEBUG] could not determine if TFE version meets minimum required version %s: %v", minTFEVersionVariableSetStacks, err)
-					return fmt.Errorf("Error while determining TFE version compatibility: %w", err)
+					return diag.Errorf("Error while determining TFE version compatibility: %s", err)
 				} else if meetsMinVersionRequirement {
 					includes = append(includes, tfe.VariableSetStacks)
 				}
@@ -149,7 +150,7 @@ func dataSourceTFEVariableSetRead(d *schema.ResourceData, meta interface{}) erro
 
 				vs, err = config.Client.VariableSets.Read(ctx, vs.ID, &readOptions)
 				if err != nil {
-					return fmt.Errorf("Error retrieving variable set relations: %w", err)
+					return diag.Errorf("Error retrieving variable set relations: %s", err)
 				}
 
 				var workspaces []interface{}
@@ -190,5 +191,5 @@ func dataSourceTFEVariableSetRead(d *schema.ResourceData, meta interface{}) erro
 		options.PageNumber = l.NextPage
 	}
 
-	return fmt.Errorf("could not find variable set %s/%s", organization, name)
+	return diag.Errorf("could not find variable set %s/%s", organization, name)
 }
@@ -9,14 +9,15 @@
 package provider
 
 import (
+	"context"
 	"errors"
-	"fmt"
 	"log"
 	"net/url"
 	"time"
 
 	tfe "github.com/hashicorp/go-tfe"
 	tfev2 "github.com/hashicorp/go-tfe/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -25,7 +26,7 @@ func dataSourceTFEWorkspace() *schema.Resource {
 		Description: "Gets information about a workspace." +
 			"\n\n~> **Note:** Using `global_remote_state` or `remote_state_consumer_ids` requires using the provider with HCP Terraform or an instance of Terraform Enterprise at least as recent as v202104-1.",
 
-		Read: dataSourceTFEWorkspaceRead,
+		ReadWithoutTimeout: dataSourceTFEWorkspaceRead,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -358,23 +359,23 @@ func dataSourceTFEWorkspace() *schema.Resource {
 	}
 }
 
-func dataSourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTFEWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the name and organization.
 	name := d.Get("name").(string)
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] Read configuration of workspace: %s", name)
 	workspace, err := config.Client.Workspaces.Read(ctx, organization, name)
 	if err != nil && errors.Is(err, tfe.ErrResourceNotFound) {
-		return fmt.Errorf("could not find workspace %s/%s", organization, name)
+		return diag.Errorf("could not find workspace %s/%s", organization, name)
 	}
 	if err != nil {
-		return fmt.Errorf("Error retrieving workspace: %w", err)
+		return diag.Errorf("Error retrieving workspace: %s", err)
 	}
 	// Update the config.
 	d.Set("allow_destroy_plan", workspace.AllowDestroyPlan)
@@ -389,7 +390,7 @@ func dataSourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error
 
 	autoDestroyAt, err := flattenAutoDestroyAt(workspace.AutoDestroyAt)
 	if err != nil {
-		return fmt.Errorf("Error flattening auto destroy during read: %w", err)
+		return diag.Errorf("Error flattening auto destroy during read: %s", err)
 	}
 	d.Set("auto_destroy_at", autoDestroyAt)
 
@@ -397,7 +398,7 @@ func dataSourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error
 	if workspace.AutoDestroyActivityDuration.IsSpecified() {
 		autoDestroyDuration, err = workspace.AutoDestroyActivityDuration.Get()
 		if err != nil {
-			return fmt.Errorf("Error reading auto destroy activity duration: %w", err)
+			return diag.Errorf("Error reading auto destroy activity duration: %s", err)
 		}
 	}
 	d.Set("auto_destroy_activity_duration", autoDestroyDuration)
@@ -486,14 +487,14 @@ func dataSourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error
 	projectRemoteState := workspace.ProjectRemoteState
 	if globalRemoteState || projectRemoteState {
 		if err := d.Set("remote_state_consumer_ids", []string{}); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
-		legacyGlobalState, remoteStateConsumerIDs, err := readWorkspaceStateConsumers(workspace.ID, config.Client)
+		legacyGlobalState, remoteStateConsumerIDs, err := readWorkspaceStateConsumers(ctx, workspace.ID, config.Client)
 
 		if err != nil {
-			return fmt.Errorf(
-				"Error reading remote state consumers for workspace %s: %w", workspace.ID, err)
+			return diag.Errorf(
+				"Error reading remote state consumers for workspace %s: %s", workspace.ID, err)
 		}
 
 		if legacyGlobalState {
@@ -511,7 +512,7 @@ func dataSourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error
 	effectiveTags := make(map[string]interface{})
 	etbResp, err := config.ClientV2.API.Workspaces().ByWorkspace_id(workspace.ID).EffectiveTagBindings().Get(ctx, nil)
 	if err != nil && !errors.Is(err, tfev2.ErrNotFound) {
-		return fmt.Errorf("Error retrieving effective tag bindings for workspace %s: %w", workspace.ID, err)
+		return diag.Errorf("Error retrieving effective tag bindings for workspace %s: %s", workspace.ID, err)
 	}
 	if etbResp != nil {
 		for _, binding := range etbResp.GetData() {
@@ -9,11 +9,13 @@
 package provider
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -22,7 +24,7 @@ func dataSourceTFEWorkspaceIDs() *schema.Resource {
 		Description: "Gets information on workspace IDs." +
 			"\n\n-> **Note:** At least one of `names` or `tag_names` must be provided.",
 
-		Read: dataSourceTFEWorkspaceIDsRead,
+		ReadWithoutTimeout: dataSourceTFEWorkspaceIDsRead,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -132,13 +134,13 @@ func includedByName(names map[string]bool, workspaceName string) bool {
 	return false
 }
 
-func dataSourceTFEWorkspaceIDsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceTFEWorkspaceIDsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the organization.
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Create a map with all the names we are looking for.
@@ -223,11 +225,11 @@ func dataSourceTFEWorkspaceIDsRead(d *schema.ResourceData, meta interface{}) err
 			options.Include = []tfe.WSIncludeOpt{}
 			wl, err = config.Client.Workspaces.List(ctx, organization, options)
 			if err != nil {
-				return fmt.Errorf("Error retrieving workspaces: %w", err)
+				return diag.Errorf("Error retrieving workspaces: %s", err)
 			}
 		}
 		if err != nil {
-			return fmt.Errorf("Error retrieving workspaces: %w", err)
+			return diag.Errorf("Error retrieving workspaces: %s", err)
 		}
 
 		for _, w := range wl.Items {
@@ -0,0 +1,246 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &dataSourceTFEWorkspaceResources{}
+	_ datasource.DataSourceWithConfigure = &dataSourceTFEWorkspaceResources{}
+)
+
+// NewWorkspaceResourcesDataSource is a helper function to simplify the provider implementation.
+func NewWorkspaceResourcesDataSource() datasource.DataSource {
+	return &dataSourceTFEWorkspaceResources{}
+}
+
+// dataSourceTFEWorkspaceResources is the data source implementation.
+type dataSourceTFEWorkspaceResources struct {
+	config ConfiguredClient
+}
+
+// modelTFEWorkspaceResources maps the data source schema data.
+type modelTFEWorkspaceResources struct {
+	ID           types.String                `tfsdk:"id"`
+	WorkspaceID  types.String                `tfsdk:"workspace_id"`
+	Types        types.Set                   `tfsdk:"types"`
+	Providers    types.Set                   `tfsdk:"providers"`
+	ModulePrefix types.String                `tfsdk:"module_prefix"`
+	Resources    []modelTFEWorkspaceResource `tfsdk:"resources"`
+}
+
+// modelTFEWorkspaceResource maps a resource of the resources attribute.
+type modelTFEWorkspaceResource struct {
+	Address  types.String `tfsdk:"address"`
+	Type     types.String `tfsdk:"type"`
+	Module   types.String `tfsdk:"module"`
+	Provider types.String `tfsdk:"provider"`
+	Name     types.String `tfsdk:"name"`
+}
+
+// workspaceResourcesFilter selects the resources of a workspace. The API
+// cannot filter them, so they are all listed and filtered by the provider.
+type workspaceResourcesFilter struct {
+	types        []string
+	providers    []string
+	modulePrefix string
+}
+
+// matches reports whether the resource, in the module with the given address,
+// is selected by the filter. Providers match either their source, such as
+// hashicorp/aws, or their type, such as aws.
+func (f workspaceResourcesFilter) matches(resource *tfe.WorkspaceResource, module string) bool {
+	if len(f.types) > 0 && !slices.Contains(f.types, resource.ProviderType) {
+		return false
+	}
+	if len(f.providers) > 0 {
+		providerType := resource.Provider[strings.LastIndex(resource.Provider, "/")+1:]
+		if !slices.Contains(f.providers, resource.Provider) && !slices.Contains(f.providers, providerType) {
+			return false
+		}
+	}
+	return strings.HasPrefix(module, f.modulePrefix)
+}
+
+// Metadata returns the data source type name.
+func (d *dataSourceTFEWorkspaceResources) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_resources"
+}
+
+// Schema defines the schema for the data source.
+func (d *dataSourceTFEWorkspaceResources) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Gets the managed resources of the current state of a workspace, optionally filtered by resource type, provider and module. Only permission to read the workspace is required, not permission to read its state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the workspace for use as an ID.",
+				Computed:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				Description: "ID of the workspace to list the resources of.",
+				Required:    true,
+			},
+			"types": schema.SetAttribute{
+				Description: "Only list the resources of these types, such as `aws_s3_bucket`.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"providers": schema.SetAttribute{
+				Description: "Only list the resources of these providers, either by source, such as `hashicorp/aws`, or by type, such as `aws`.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"module_prefix": schema.StringAttribute{
+				Description: "Only list the resources of the modules whose address starts with this prefix, such as `module.network`. Resources of the root module have an empty module address.",
+				Optional:    true,
+			},
+			"resources": schema.ListNestedAttribute{
+				Description: "List of the managed resources of the workspace, sorted by address.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"address": schema.StringAttribute{
+							Description: "Address of the resource, such as `module.network.aws_subnet.private[0]`.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of the resource, such as `aws_subnet`.",
+							Computed:    true,
+						},
+						"module": schema.StringAttribute{
+							Description: "Address of the module of the resource, such as `module.network`, or an empty string for the root module.",
+							Computed:    true,
+						},
+						"provider": schema.StringAttribute{
+							Description: "Source of the provider of the resource, such as `hashicorp/aws`.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the resource, such as `private`.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *dataSourceTFEWorkspaceResources) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ConfiguredClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected tfe.ConfiguredClient, got %T. This is a bug in the tfe provider, so please report it on GitHub.", req.ProviderData),
+		)
+
+		return
+	}
+	d.config = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dataSourceTFEWorkspaceResources) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model modelTFEWorkspaceResources
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var filter workspaceResourcesFilter
+	resp.Diagnostics.Append(model.Types.ElementsAs(ctx, &filter.types, true)...)
+	resp.Diagnostics.Append(model.Providers.ElementsAs(ctx, &filter.providers, true)...)
+	filter.modulePrefix = model.ModulePrefix.ValueString()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID := model.WorkspaceID.ValueString()
+	resources, err := listWorkspaceResources(ctx, d.config.Client, workspaceID, filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to list workspace resources", err.Error())
+		return
+	}
+
+	model.ID = types.StringValue(workspaceID)
+	model.Resources = resources
+
+	// Save model into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// listWorkspaceResources lists the resources of the workspace matching the
+// filter, sorted by address.
+func listWorkspaceResources(ctx context.Context, tfeClient *tfe.Client, workspaceID string, filter workspaceResourcesFilter) ([]modelTFEWorkspaceResource, error) {
+	options := &tfe.WorkspaceResourceListOptions{}
+
+	resources := []modelTFEWorkspaceResource{}
+	for {
+		tflog.Debug(ctx, "Listing workspace resources", map[string]any{"workspace_id": workspaceID, "page": options.PageNumber})
+		rl, err := tfeClient.WorkspaceResources.List(ctx, workspaceID, options)
+		if err != nil {
+			return nil, fmt.Errorf("error listing resources of workspace %s: %w", workspaceID, err)
+		}
+
+		for _, resource := range rl.Items {
+			// The API reports the module of the resources of the root module
+			// as "root".
+			module := resource.Module
+			if module == "root" {
+				module = ""
+			}
+			if !filter.matches(resource, module) {
+				continue
+			}
+			resources = append(resources, modelTFEWorkspaceResource{
+				Address:  types.StringValue(resource.Address),
+				Type:     types.StringValue(resource.ProviderType),
+				Module:   types.StringValue(module),
+				Provider: types.StringValue(resource.Provider),
+				Name:     types.StringValue(resource.Name),
+			})
+		}
+
+		// Exit the loop when we've seen all pages.
+		if rl.Pagination == nil || rl.CurrentPage >= rl.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = rl.NextPage
+	}
+
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].Address.ValueString() < resources[j].Address.ValueString()
+	})
+	return resources, nil
+}
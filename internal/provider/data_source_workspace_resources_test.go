@@ -0,0 +1,171 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	tfemocks "github.com/hashicorp/go-tfe/mocks"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListWorkspaceResources(t *testing.T) {
+	items := []*tfe.WorkspaceResource{
+		{Address: "module.storage.aws_s3_bucket.logs", Name: "logs", Module: "module.storage", Provider: "hashicorp/aws", ProviderType: "aws_s3_bucket"},
+		{Address: "aws_s3_bucket.assets", Name: "assets", Module: "root", Provider: "hashicorp/aws", ProviderType: "aws_s3_bucket"},
+		{Address: "module.network.aws_vpc.main", Name: "main", Module: "module.network", Provider: "hashicorp/aws", ProviderType: "aws_vpc"},
+		{Address: "random_pet.name", Name: "name", Module: "root", Provider: "hashicorp/random", ProviderType: "random_pet"},
+	}
+
+	cases := map[string]struct {
+		filter   workspaceResourcesFilter
+		expected []string
+		modules  []string
+	}{
+		"no filter": {
+			expected: []string{"aws_s3_bucket.assets", "module.network.aws_vpc.main", "module.storage.aws_s3_bucket.logs", "random_pet.name"},
+			modules:  []string{"", "module.network", "module.storage", ""},
+		},
+		"types": {
+			filter:   workspaceResourcesFilter{types: []string{"aws_s3_bucket"}},
+			expected: []string{"aws_s3_bucket.assets", "module.storage.aws_s3_bucket.logs"},
+		},
+		"provider source": {
+			filter:   workspaceResourcesFilter{providers: []string{"hashicorp/random"}},
+			expected: []string{"random_pet.name"},
+		},
+		"provider type": {
+			filter:   workspaceResourcesFilter{providers: []string{"aws"}},
+			expected: []string{"aws_s3_bucket.assets", "module.network.aws_vpc.main", "module.storage.aws_s3_bucket.logs"},
+		},
+		"module prefix": {
+			filter:   workspaceResourcesFilter{types: []string{"aws_s3_bucket"}, modulePrefix: "module.storage"},
+			expected: []string{"module.storage.aws_s3_bucket.logs"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := testTfeClient(t, testClientOptions{})
+			ctrl := gomock.NewController(t)
+			mockWorkspaceResourcesAPI := tfemocks.NewMockWorkspaceResources(ctrl)
+			gomock.InOrder(
+				mockWorkspaceResourcesAPI.
+					EXPECT().
+					List(gomock.Any(), "ws-01", &tfe.WorkspaceResourceListOptions{}).
+					Return(&tfe.WorkspaceResourcesList{
+						Pagination: &tfe.Pagination{CurrentPage: 1, NextPage: 2, TotalPages: 2},
+						Items:      items[:2],
+					}, nil),
+				mockWorkspaceResourcesAPI.
+					EXPECT().
+					List(gomock.Any(), "ws-01", &tfe.WorkspaceResourceListOptions{ListOptions: tfe.ListOptions{PageNumber: 2}}).
+					Return(&tfe.WorkspaceResourcesList{
+						Pagination: &tfe.Pagination{CurrentPage: 2, TotalPages: 2},
+						Items:      items[2:],
+					}, nil),
+			)
+			client.WorkspaceResources = mockWorkspaceResourcesAPI
+
+			resources, err := listWorkspaceResources(context.Background(), client, "ws-01", tc.filter)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			addresses := []string{}
+			modules := []string{}
+			for _, resource := range resources {
+				addresses = append(addresses, resource.Address.ValueString())
+				modules = append(modules, resource.Module.ValueString())
+			}
+			if fmt.Sprint(addresses) != fmt.Sprint(tc.expected) {
+				t.Fatalf("expected resources %v, got %v", tc.expected, addresses)
+			}
+			if tc.modules != nil && fmt.Sprintf("%q", modules) != fmt.Sprintf("%q", tc.modules) {
+				t.Fatalf("expected modules %q, got %q", tc.modules, modules)
+			}
+		})
+	}
+}
+
+func TestAccTFEWorkspaceResourcesDataSource_basic(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	tfeClient, err := getClientUsingEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	organization, orgCleanup := createOrganization(t, tfeClient, tfe.OrganizationCreateOptions{
+		Name:  tfe.String(fmt.Sprintf("tst-org-%d", rInt)),
+		Email: tfe.String("admin@terraformer.inc"),
+	})
+	t.Cleanup(orgCleanup)
+
+	parentWorkspace, _ := setupWorkspacesWithConfig(t, tfeClient, rInt, organization.Name, "test-fixtures/basic-config")
+	createAppliedTFERun(t, tfeClient, parentWorkspace)
+	waitForWorkspaceResources(t, tfeClient, parentWorkspace.ID)
+	resourceAddress := "data.tfe_workspace_resources.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEWorkspaceResourcesDataSourceConfig(parentWorkspace.ID, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceAddress, "id", parentWorkspace.ID),
+					resource.TestCheckResourceAttr(resourceAddress, "resources.#", "1"),
+					resource.TestCheckResourceAttr(resourceAddress, "resources.0.address", "random_pet.always_new"),
+					resource.TestCheckResourceAttr(resourceAddress, "resources.0.type", "random_pet"),
+					resource.TestCheckResourceAttr(resourceAddress, "resources.0.name", "always_new"),
+					resource.TestCheckResourceAttr(resourceAddress, "resources.0.module", ""),
+					resource.TestCheckResourceAttr(resourceAddress, "resources.0.provider", "hashicorp/random"),
+				),
+			},
+			{
+				Config: testAccTFEWorkspaceResourcesDataSourceConfig(parentWorkspace.ID, `providers = ["random"]`),
+				Check:  resource.TestCheckResourceAttr(resourceAddress, "resources.#", "1"),
+			},
+			{
+				Config: testAccTFEWorkspaceResourcesDataSourceConfig(parentWorkspace.ID, `types = ["aws_s3_bucket"]`),
+				Check:  resource.TestCheckResourceAttr(resourceAddress, "resources.#", "0"),
+			},
+		},
+	})
+}
+
+// waitForWorkspaceResources waits for the resources of the state of the
+// applied run to be listed, as the state is processed asynchronously.
+func waitForWorkspaceResources(t *testing.T, client *tfe.Client, workspaceID string) {
+	t.Helper()
+	_, err := retryFn(15, 4, func() (interface{}, error) {
+		rl, err := client.WorkspaceResources.List(context.Background(), workspaceID, &tfe.WorkspaceResourceListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("could not list workspace resources: %w", err)
+		}
+		if len(rl.Items) == 0 {
+			return nil, errors.New("workspace resources are not ready")
+		}
+		return rl.Items, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccTFEWorkspaceResourcesDataSourceConfig(workspaceID, filter string) string {
+	return fmt.Sprintf(`
+data "tfe_workspace_resources" "test" {
+  workspace_id = "%s"
+  %s
+}`, workspaceID, filter)
+}
@@ -150,7 +150,7 @@ func (d *dataSourceWorkspaceRunTask) Read(ctx context.Context, req datasource.Re
 		return
 	}
 
-	result := modelFromTFEWorkspaceRunTaskV2(wstask)
+	result := modelFromTFEWorkspaceRunTaskV2(ctx, wstask)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
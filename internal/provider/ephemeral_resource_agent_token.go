@@ -70,5 +70,10 @@ func (e *AgentTokenEphemeralResource) Metadata(ctx context.Context, req ephemera
 
 // The request contains the configuration supplied to Terraform for the ephemeral resource. The response contains the ephemeral result data. The data is defined by the schema of the ephemeral resource.
 func (e *AgentTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if e.config.ReadOnly {
+		resp.Diagnostics.AddError(readOnlySummary, readOnlyEphemeralDetail)
+		return
+	}
+
 	// No-op
 }
@@ -73,5 +73,10 @@ func (e *AuditTrailTokenEphemeralResource) Metadata(ctx context.Context, req eph
 }
 
 func (e *AuditTrailTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if e.config.ReadOnly {
+		resp.Diagnostics.AddError(readOnlySummary, readOnlyEphemeralDetail)
+		return
+	}
+
 	// No-op
 }
@@ -73,5 +73,10 @@ func (e *OrganizationTokenEphemeralResource) Metadata(ctx context.Context, req e
 }
 
 func (e *OrganizationTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if e.config.ReadOnly {
+		resp.Diagnostics.AddError(readOnlySummary, readOnlyEphemeralDetail)
+		return
+	}
+
 	// No-op
 }
@@ -97,6 +97,11 @@ func (e *TeamTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral
 }
 
 func (e *TeamTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if e.config.ReadOnly {
+		resp.Diagnostics.AddError(readOnlySummary, readOnlyEphemeralDetail)
+		return
+	}
+
 	var config TeamTokenEphemeralResourceModel
 
 	// Read Terraform config data into the model
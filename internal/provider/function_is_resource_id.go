@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &functionIsResourceID{}
+
+// resourceIDPrefixRegexp matches the prefixes of resource IDs, such as "ws"
+// or "polset".
+var resourceIDPrefixRegexp = regexp.MustCompile(`^[a-z]+$`)
+
+func NewIsResourceIDFunction() function.Function {
+	return &functionIsResourceID{}
+}
+
+type functionIsResourceID struct{}
+
+func (f *functionIsResourceID) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "is_resource_id"
+}
+
+func (f *functionIsResourceID) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Check whether a string is a resource ID with the given prefix",
+		Description: "Returns `true` when the string has the format of an HCP Terraform or Terraform Enterprise resource ID " +
+			"with the given prefix, such as `ws-CZcmD7eagjhyXavN` for the prefix `ws`, and `false` otherwise.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "prefix",
+				Description: "The prefix of the resource ID without the trailing hyphen, such as `ws`, `prj` or `team`.",
+			},
+			function.StringParameter{
+				Name:        "id",
+				Description: "The string to check.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *functionIsResourceID) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var prefix, id string
+	resp.Error = req.Arguments.Get(ctx, &prefix, &id)
+	if resp.Error != nil {
+		return
+	}
+
+	if !resourceIDPrefixRegexp.MatchString(prefix) {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid resource ID prefix %q: must only contain lowercase letters, such as \"ws\"", prefix))
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, isResourceIDFormat(prefix, id))
+}
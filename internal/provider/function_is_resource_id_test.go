@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestIsResourceIDFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccMuxedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testIsResourceIDFunctionConfig("ws", "ws-CZcmD7eagjhyXavN"),
+				Check:  resource.TestCheckOutput("result", "true"),
+			},
+			{
+				Config: testIsResourceIDFunctionConfig("prj", "ws-CZcmD7eagjhyXavN"),
+				Check:  resource.TestCheckOutput("result", "false"),
+			},
+			{
+				Config: testIsResourceIDFunctionConfig("ws", "my-workspace"),
+				Check:  resource.TestCheckOutput("result", "false"),
+			},
+			{
+				Config:      testIsResourceIDFunctionConfig("ws-", "ws-CZcmD7eagjhyXavN"),
+				ExpectError: regexp.MustCompile(`invalid resource ID prefix`),
+			},
+		},
+	})
+}
+
+func testIsResourceIDFunctionConfig(prefix, id string) string {
+	return fmt.Sprintf(`
+output "result" {
+  value = provider::tfe::is_resource_id(%q, %q)
+}
+`, prefix, id)
+}
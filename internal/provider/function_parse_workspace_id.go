@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &functionParseWorkspaceID{}
+
+// parseWorkspaceIDAttrTypes are the attributes of the object returned by
+// parse_workspace_id.
+var parseWorkspaceIDAttrTypes = map[string]attr.Type{
+	"organization": types.StringType,
+	"name":         types.StringType,
+}
+
+func NewParseWorkspaceIDFunction() function.Function {
+	return &functionParseWorkspaceID{}
+}
+
+type functionParseWorkspaceID struct{}
+
+func (f *functionParseWorkspaceID) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_workspace_id"
+}
+
+func (f *functionParseWorkspaceID) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse a workspace ID of the form ORGANIZATION/WORKSPACE",
+		Description: "Parses a human readable workspace ID of the form `ORGANIZATION/WORKSPACE`, as used to import workspaces, " +
+			"into an object with `organization` and `name` attributes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "id",
+				Description: "The workspace ID, such as `my-org/my-workspace`.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: parseWorkspaceIDAttrTypes,
+		},
+	}
+}
+
+func (f *functionParseWorkspaceID) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+	resp.Error = req.Arguments.Get(ctx, &id)
+	if resp.Error != nil {
+		return
+	}
+
+	// Unlike importing, only the ORGANIZATION/WORKSPACE format is accepted,
+	// so the legacy WORKSPACE|ORGANIZATION format and extra slashes are
+	// rejected.
+	organization, name, ok := strings.Cut(id, "/")
+	if !ok || organization == "" || name == "" || strings.Contains(name, "/") || strings.Contains(id, "|") {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid workspace ID format: %s (expected <ORGANIZATION>/<WORKSPACE>)", id))
+		return
+	}
+
+	result, diags := types.ObjectValue(parseWorkspaceIDAttrTypes, map[string]attr.Value{
+		"organization": types.StringValue(organization),
+		"name":         types.StringValue(name),
+	})
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, result)
+}
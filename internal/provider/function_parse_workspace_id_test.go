@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestParseWorkspaceIDFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccMuxedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testParseWorkspaceIDFunctionConfig("my-org/my-workspace"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("organization", "my-org"),
+					resource.TestCheckOutput("name", "my-workspace"),
+				),
+			},
+			{
+				Config:      testParseWorkspaceIDFunctionConfig("my-workspace|my-org"),
+				ExpectError: regexp.MustCompile(`invalid workspace ID format`),
+			},
+			{
+				Config:      testParseWorkspaceIDFunctionConfig("my-org/my|workspace"),
+				ExpectError: regexp.MustCompile(`invalid workspace ID format`),
+			},
+			{
+				Config:      testParseWorkspaceIDFunctionConfig("my-org/my-workspace/extra"),
+				ExpectError: regexp.MustCompile(`invalid workspace ID format`),
+			},
+			{
+				Config:      testParseWorkspaceIDFunctionConfig("my-workspace"),
+				ExpectError: regexp.MustCompile(`invalid workspace ID format`),
+			},
+			{
+				Config:      testParseWorkspaceIDFunctionConfig("my-org/"),
+				ExpectError: regexp.MustCompile(`invalid workspace ID format`),
+			},
+		},
+	})
+}
+
+func testParseWorkspaceIDFunctionConfig(id string) string {
+	return `
+locals {
+  workspace = provider::tfe::parse_workspace_id("` + id + `")
+}
+
+output "organization" {
+  value = local.workspace.organization
+}
+
+output "name" {
+  value = local.workspace.name
+}
+`
+}
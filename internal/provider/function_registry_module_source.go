@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	svchost "github.com/hashicorp/terraform-svchost"
+)
+
+var _ function.Function = &functionRegistryModuleSource{}
+
+func NewRegistryModuleSourceFunction() function.Function {
+	return &functionRegistryModuleSource{}
+}
+
+type functionRegistryModuleSource struct{}
+
+func (f *functionRegistryModuleSource) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "registry_module_source"
+}
+
+func (f *functionRegistryModuleSource) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build the source address of a private registry module",
+		Description: "Returns the source address of a module in the private registry of an HCP Terraform or Terraform Enterprise organization, " +
+			"of the form `HOSTNAME/ORGANIZATION/NAME/PROVIDER`, for use in the `source` argument of a `module` block.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "hostname",
+				Description: "The hostname of HCP Terraform or Terraform Enterprise, such as `app.terraform.io`.",
+			},
+			function.StringParameter{
+				Name:        "organization",
+				Description: "The name of the organization that owns the module.",
+			},
+			function.StringParameter{
+				Name:        "name",
+				Description: "The name of the module.",
+			},
+			function.StringParameter{
+				Name:        "provider",
+				Description: "The name of the main provider of the module, such as `aws`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *functionRegistryModuleSource) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var hostname, organization, name, provider string
+	resp.Error = req.Arguments.Get(ctx, &hostname, &organization, &name, &provider)
+	if resp.Error != nil {
+		return
+	}
+
+	if _, err := svchost.ForComparison(hostname); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid hostname %q: %s", hostname, err))
+		return
+	}
+
+	for i, part := range []string{organization, name, provider} {
+		if part == "" || strings.Contains(part, "/") {
+			resp.Error = function.NewArgumentFuncError(int64(i+1), fmt.Sprintf("invalid value %q: must not be empty or contain \"/\"", part))
+			return
+		}
+	}
+
+	resp.Error = resp.Result.Set(ctx, strings.Join([]string{hostname, organization, name, provider}, "/"))
+}
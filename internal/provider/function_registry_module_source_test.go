@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestRegistryModuleSourceFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccMuxedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testRegistryModuleSourceFunctionConfig("app.terraform.io", "my-org", "vpc", "aws"),
+				Check:  resource.TestCheckOutput("source", "app.terraform.io/my-org/vpc/aws"),
+			},
+			{
+				Config:      testRegistryModuleSourceFunctionConfig("https://app.terraform.io", "my-org", "vpc", "aws"),
+				ExpectError: regexp.MustCompile(`invalid hostname`),
+			},
+			{
+				Config:      testRegistryModuleSourceFunctionConfig("app.terraform.io", "my-org", "", "aws"),
+				ExpectError: regexp.MustCompile(`must not be empty`),
+			},
+			{
+				Config:      testRegistryModuleSourceFunctionConfig("app.terraform.io", "my-org/vpc", "vpc", "aws"),
+				ExpectError: regexp.MustCompile(`must not be empty or contain "/"`),
+			},
+		},
+	})
+}
+
+func testRegistryModuleSourceFunctionConfig(hostname, organization, name, provider string) string {
+	return fmt.Sprintf(`
+output "source" {
+  value = provider::tfe::registry_module_source(%q, %q, %q, %q)
+}
+`, hostname, organization, name, provider)
+}
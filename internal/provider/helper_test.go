@@ -28,6 +28,9 @@ const EnableHYOKEnvName = "ENABLE_HYOK"
 
 const TFESCIMGroupAPI = "https://%s/scim/v2/Groups"
 
+// ctx is used as default context.Context when making TFE calls in tests.
+var ctx = context.Background()
+
 type testClientOptions struct {
 	defaultOrganization          string
 	defaultWorkspaceID           string
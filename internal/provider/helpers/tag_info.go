@@ -42,3 +42,44 @@ func NewTagInfo(configBindings map[string]interface{}, calculatedEffective []*tf
 		SelfTags:      tagBindingsMap,
 	}
 }
+
+// MergeDefaultTags returns the provider default tags merged with the tags set
+// on the resource. Resource tags win when both set the same key.
+func MergeDefaultTags(defaultTags, tags map[string]string) map[string]string {
+	merged := make(map[string]string, len(defaultTags)+len(tags))
+	for key, value := range defaultTags {
+		merged[key] = value
+	}
+	for key, value := range tags {
+		merged[key] = value
+	}
+	return merged
+}
+
+// RemoveDefaultTags returns the tags without those that come from the provider
+// default tags, so that default tags do not show up as drift in the resource
+// tags. A default tag is kept when the resource sets it to the same value in
+// configured, or when its value differs from the default.
+func RemoveDefaultTags(defaultTags, tags, configured map[string]string) map[string]string {
+	result := make(map[string]string, len(tags))
+	for key, value := range tags {
+		if defaultValue, ok := defaultTags[key]; ok && defaultValue == value {
+			if configuredValue, ok := configured[key]; !ok || configuredValue != value {
+				continue
+			}
+		}
+		result[key] = value
+	}
+	return result
+}
+
+// StringMap converts a map read from a schema.TypeMap of strings.
+func StringMap(m map[string]interface{}) map[string]string {
+	result := make(map[string]string, len(m))
+	for key, value := range m {
+		if s, ok := value.(string); ok {
+			result[key] = s
+		}
+	}
+	return result
+}
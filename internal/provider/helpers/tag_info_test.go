@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeDefaultTags(t *testing.T) {
+	defaultTags := map[string]string{"owner": "platform", "cost-center": "42"}
+
+	got := MergeDefaultTags(defaultTags, map[string]string{"owner": "team-a", "env": "prod"})
+	expected := map[string]string{"owner": "team-a", "cost-center": "42", "env": "prod"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+
+	if got := MergeDefaultTags(nil, nil); len(got) != 0 {
+		t.Fatalf("expected no tags, got %v", got)
+	}
+}
+
+func TestRemoveDefaultTags(t *testing.T) {
+	defaultTags := map[string]string{"owner": "platform", "cost-center": "42"}
+
+	testCases := map[string]struct {
+		tags       map[string]string
+		configured map[string]string
+		expected   map[string]string
+	}{
+		"default tags are removed": {
+			tags:       map[string]string{"owner": "platform", "cost-center": "42", "env": "prod"},
+			configured: map[string]string{"env": "prod"},
+			expected:   map[string]string{"env": "prod"},
+		},
+		"configured default tags are kept": {
+			tags:       map[string]string{"owner": "platform", "cost-center": "42"},
+			configured: map[string]string{"owner": "platform"},
+			expected:   map[string]string{"owner": "platform"},
+		},
+		"overridden default tags are kept": {
+			tags:       map[string]string{"owner": "team-a", "cost-center": "42"},
+			configured: map[string]string{"owner": "team-a"},
+			expected:   map[string]string{"owner": "team-a"},
+		},
+		"changed default tags show up as drift": {
+			tags:       map[string]string{"owner": "someone-else"},
+			configured: map[string]string{},
+			expected:   map[string]string{"owner": "someone-else"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := RemoveDefaultTags(defaultTags, tc.tags, tc.configured)
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Fatalf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
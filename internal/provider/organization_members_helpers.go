@@ -17,9 +17,45 @@ import (
 	memberitemparams "github.com/hashicorp/go-tfe/v2/api/organizationmemberships/item"
 	"github.com/hashicorp/go-tfe/v2/api/organizations"
 	orgmembershipparams "github.com/hashicorp/go-tfe/v2/api/organizations/item/organizationmemberships"
+	"github.com/hashicorp/terraform-provider-tfe/internal/client"
 )
 
-func fetchOrganizationMembers(client *tfev2.Client, orgName string) ([]map[string]string, []map[string]string, error) {
+// organizationMembers holds the results of fetchOrganizationMembers in the
+// lookup cache.
+type organizationMembers struct {
+	active  []map[string]string
+	waiting []map[string]string
+}
+
+// lookupOrganizationMembers is fetchOrganizationMembers backed by the lookup
+// cache.
+func (c ConfiguredClient) lookupOrganizationMembers(ctx context.Context, orgName string) ([]map[string]string, []map[string]string, error) {
+	members, err := client.Lookup(c.Lookups, client.LookupOrganizationMemberships, "members "+orgName, func() (organizationMembers, error) {
+		active, waiting, err := fetchOrganizationMembers(ctx, c.ClientV2, orgName)
+		return organizationMembers{active: active, waiting: waiting}, err
+	})
+	return members.active, members.waiting, err
+}
+
+// lookupOrganizationMemberByNameOrEmail is fetchOrganizationMemberByNameOrEmail
+// backed by the lookup cache.
+func (c ConfiguredClient) lookupOrganizationMemberByNameOrEmail(ctx context.Context, organization, username, email string) (*tfe.OrganizationMembership, error) {
+	key := fmt.Sprintf("member %s/%s/%s", organization, username, email)
+	return client.Lookup(c.Lookups, client.LookupOrganizationMemberships, key, func() (*tfe.OrganizationMembership, error) {
+		return fetchOrganizationMemberByNameOrEmail(ctx, c.Client, organization, username, email)
+	})
+}
+
+// lookupOrganizationMemberByNameOrEmailV2 is
+// fetchOrganizationMemberByNameOrEmailV2 backed by the lookup cache.
+func (c ConfiguredClient) lookupOrganizationMemberByNameOrEmailV2(ctx context.Context, organization, username, email string) (models.OrganizationMembershipsable, error) {
+	key := fmt.Sprintf("member v2 %s/%s/%s", organization, username, email)
+	return client.Lookup(c.Lookups, client.LookupOrganizationMemberships, key, func() (models.OrganizationMembershipsable, error) {
+		return fetchOrganizationMemberByNameOrEmailV2(ctx, c.ClientV2, organization, username, email)
+	})
+}
+
+func fetchOrganizationMembers(ctx context.Context, client *tfev2.Client, orgName string) ([]map[string]string, []map[string]string, error) {
 	var members []map[string]string
 	var membersWaiting []map[string]string
 
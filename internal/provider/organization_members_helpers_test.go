@@ -118,7 +118,7 @@ func TestFetchOrganizationMembers(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			client := testTfeClientV2(t, membershipsHandler(orgName, test.pages))
 
-			receivedMembers, receivedMembersWaiting, err := fetchOrganizationMembers(client, test.org)
+			receivedMembers, receivedMembersWaiting, err := fetchOrganizationMembers(ctx, client, test.org)
 
 			if (err != nil) != test.err {
 				t.Fatalf("expected error is %t, got %v", test.err, err)
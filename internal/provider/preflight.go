@@ -0,0 +1,191 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-tfe/internal/client"
+)
+
+// featureRequirementsSummary is the summary of the diagnostics reported when
+// a resource needs a feature that the organization or instance lacks.
+const featureRequirementsSummary = "Feature not available"
+
+// featureRequirements are what a resource needs from the organization and the
+// instance. They are checked when planning, so that a missing entitlement or
+// an outdated Terraform Enterprise fails the plan instead of the apply.
+type featureRequirements struct {
+	// feature names what needs the requirements, such as "tfe_agent_pool".
+	feature string
+
+	// entitlements are the organization entitlements the feature needs, named
+	// like the entitlements of the tfe_instance data source.
+	entitlements []string
+
+	// minTFEVersion is the minimum version of Terraform Enterprise the feature
+	// needs. HCP Terraform always meets it.
+	minTFEVersion string
+}
+
+// entitlementGetters read the entitlements of an organization by the names
+// used in the tfe_instance data source and in featureRequirements.
+var entitlementGetters = map[string]func(*tfe.Entitlements) bool{
+	"agents":                  func(e *tfe.Entitlements) bool { return e.Agents },
+	"audit_logging":           func(e *tfe.Entitlements) bool { return e.AuditLogging },
+	"cost_estimation":         func(e *tfe.Entitlements) bool { return e.CostEstimation },
+	"global_run_tasks":        func(e *tfe.Entitlements) bool { return e.GlobalRunTasks },
+	"operations":              func(e *tfe.Entitlements) bool { return e.Operations },
+	"private_module_registry": func(e *tfe.Entitlements) bool { return e.PrivateModuleRegistry },
+	"private_run_tasks":       func(e *tfe.Entitlements) bool { return e.PrivateRunTasks },
+	"run_tasks":               func(e *tfe.Entitlements) bool { return e.RunTasks },
+	"sentinel":                func(e *tfe.Entitlements) bool { return e.Sentinel },
+	"sso":                     func(e *tfe.Entitlements) bool { return e.SSO },
+	"state_storage":           func(e *tfe.Entitlements) bool { return e.StateStorage },
+	"teams":                   func(e *tfe.Entitlements) bool { return e.Teams },
+	"vcs_integrations":        func(e *tfe.Entitlements) bool { return e.VCSIntegrations },
+}
+
+// missingEntitlements returns the names of the given entitlements the
+// organization does not have.
+func missingEntitlements(entitlements *tfe.Entitlements, names []string) []string {
+	var missing []string
+	for _, name := range names {
+		if !entitlementGetters[name](entitlements) {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
+
+// organizationEntitlements reads the entitlements of an organization, reusing
+// them across the resources of a plan.
+func (c ConfiguredClient) organizationEntitlements(ctx context.Context, organization string) (*tfe.Entitlements, error) {
+	return client.Lookup(c.Lookups, client.LookupEntitlementSet, organization, func() (*tfe.Entitlements, error) {
+		return c.Client.Organizations.ReadEntitlements(ctx, organization)
+	})
+}
+
+// checkFeatureRequirements returns an error naming the minimum version of
+// Terraform Enterprise or the entitlements of the organization that are
+// missing for the feature. The entitlements are not checked when the
+// organization is empty. Requirements that cannot be checked, for example
+// because the token cannot read the entitlements, are skipped so that the
+// apply reports the actual error.
+func (c ConfiguredClient) checkFeatureRequirements(ctx context.Context, organization string, requirements featureRequirements) error {
+	if requirements.minTFEVersion != "" {
+		meets, err := c.MeetsMinRemoteTFEVersion(requirements.minTFEVersion)
+		if err != nil {
+			log.Printf("[DEBUG] Skipping the version requirement of %s: could not determine if Terraform Enterprise version %s meets minimum required version %s: %v",
+				requirements.feature, c.RemoteTFEVersion(), requirements.minTFEVersion, err)
+		} else if !meets {
+			return fmt.Errorf("%s requires Terraform Enterprise version %s or later. Current version: %s",
+				requirements.feature, requirements.minTFEVersion, c.RemoteTFEVersion())
+		}
+	}
+
+	if organization == "" || len(requirements.entitlements) == 0 {
+		return nil
+	}
+
+	entitlements, err := c.organizationEntitlements(ctx, organization)
+	if err != nil {
+		log.Printf("[DEBUG] Skipping the entitlement requirements of %s: error reading entitlements of organization %s: %v",
+			requirements.feature, organization, err)
+		return nil
+	}
+
+	if missing := missingEntitlements(entitlements, requirements.entitlements); len(missing) > 0 {
+		return fmt.Errorf("%s requires the %s entitlement(s), which organization %s does not have. "+
+			"The entitlements of an organization depend on its plan on HCP Terraform or on the license of Terraform Enterprise, "+
+			"and can be read with the tfe_instance data source",
+			requirements.feature, strings.Join(missing, ", "), organization)
+	}
+
+	return nil
+}
+
+// plannedOrganization returns the organization of a new resource, falling
+// back to the provider default organization when it is not configured. It
+// returns an empty string when the configured organization is not known yet.
+// The configuration is read rather than the plan, as the organization
+// attribute is also computed and so planned as unknown when left out.
+func plannedOrganization(diff *schema.ResourceDiff, config ConfiguredClient) string {
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() {
+		if !diff.NewValueKnown("organization") {
+			return ""
+		}
+		if organization := diff.Get("organization").(string); organization != "" {
+			return organization
+		}
+		return config.Organization
+	}
+
+	organization := rawConfig.GetAttr("organization")
+	switch {
+	case !organization.IsKnown():
+		return ""
+	case organization.IsNull() || organization.AsString() == "":
+		return config.Organization
+	}
+	return organization.AsString()
+}
+
+// customizeDiffFeatureRequirements fails the plan of a new resource when the
+// organization or the instance does not meet the requirements.
+func customizeDiffFeatureRequirements(requirements featureRequirements) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if diff.Id() != "" {
+			return nil
+		}
+
+		config := meta.(ConfiguredClient)
+		organization := ""
+		if len(requirements.entitlements) > 0 {
+			organization = plannedOrganization(diff, config)
+		}
+
+		return config.checkFeatureRequirements(ctx, organization, requirements)
+	}
+}
+
+// modifyPlanForFeatureRequirements is the plugin framework counterpart of
+// customizeDiffFeatureRequirements. Resources with entitlement requirements
+// must have an organization attribute.
+func modifyPlanForFeatureRequirements(ctx context.Context, config ConfiguredClient, requirements featureRequirements, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	organization := ""
+	if len(requirements.entitlements) > 0 {
+		// The organization is read from the configuration, as it is planned
+		// as unknown when left out to use the provider default organization.
+		var configOrg types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("organization"), &configOrg)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !configOrg.IsUnknown() {
+			organization = configOrg.ValueString()
+			if organization == "" {
+				organization = config.Organization
+			}
+		}
+	}
+
+	if err := config.checkFeatureRequirements(ctx, organization, requirements); err != nil {
+		resp.Diagnostics.AddError(featureRequirementsSummary, err.Error())
+	}
+}
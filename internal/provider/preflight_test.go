@@ -0,0 +1,249 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-tfe/internal/client"
+)
+
+// testPreflightClient creates a client for an instance with the given app
+// name and version, whose organization "hashicorp" has the given
+// entitlements. The returned counter counts the reads of the entitlements.
+func testPreflightClient(t *testing.T, appName, tfeVersion string, entitlements map[string]bool) (ConfiguredClient, *int) {
+	t.Helper()
+
+	var reads int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("TFP-AppName", appName)
+		w.Header().Set("TFP-API-Version", "2.6")
+		w.Header().Set("X-TFE-Current-Version", tfeVersion)
+
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/organizations/hashicorp/entitlement-set":
+			reads++
+			attributes := make([]string, 0, len(entitlements))
+			for name, value := range entitlements {
+				attributes = append(attributes, fmt.Sprintf("%q: %t", strings.ReplaceAll(name, "_", "-"), value))
+			}
+			w.Header().Set("Content-Type", "application/vnd.api+json")
+			fmt.Fprintf(w, `{"data": {"id": "org-123", "type": "entitlement-sets", "attributes": {%s}}}`, strings.Join(attributes, ", "))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	tfeClient, err := tfe.NewClient(&tfe.Config{
+		Address: server.URL,
+		Token:   "not-a-token",
+	})
+	if err != nil {
+		t.Fatalf("error creating tfe client: %v", err)
+	}
+
+	return ConfiguredClient{
+		Client:  tfeClient,
+		Lookups: client.NewLookupCache(time.Minute),
+	}, &reads
+}
+
+func TestCheckFeatureRequirements(t *testing.T) {
+	cases := map[string]struct {
+		appName      string
+		tfeVersion   string
+		organization string
+		requirements featureRequirements
+		err          string
+	}{
+		"entitled": {
+			appName:      "HCP Terraform",
+			organization: "hashicorp",
+			requirements: featureRequirements{feature: "tfe_agent_pool", entitlements: []string{"agents"}},
+		},
+		"missing entitlement": {
+			appName:      "HCP Terraform",
+			organization: "hashicorp",
+			requirements: featureRequirements{feature: "tfe_policy_set", entitlements: []string{"agents", "sentinel", "run_tasks"}},
+			err:          "tfe_policy_set requires the sentinel, run_tasks entitlement(s), which organization hashicorp does not have",
+		},
+		"unknown organization": {
+			appName:      "HCP Terraform",
+			requirements: featureRequirements{feature: "tfe_policy_set", entitlements: []string{"sentinel"}},
+		},
+		"unreadable entitlements": {
+			appName:      "HCP Terraform",
+			organization: "other",
+			requirements: featureRequirements{feature: "tfe_policy_set", entitlements: []string{"sentinel"}},
+		},
+		"cloud meets any version": {
+			appName:      "HCP Terraform",
+			requirements: featureRequirements{feature: "tfe_stack", minTFEVersion: "1.0.0"},
+		},
+		"recent enterprise": {
+			appName:      "Terraform Enterprise",
+			tfeVersion:   "1.0.2",
+			requirements: featureRequirements{feature: "tfe_stack", minTFEVersion: "1.0.0"},
+		},
+		"outdated enterprise": {
+			appName:      "Terraform Enterprise",
+			tfeVersion:   "0.9.0",
+			requirements: featureRequirements{feature: "tfe_stack", minTFEVersion: "1.0.0"},
+			err:          "tfe_stack requires Terraform Enterprise version 1.0.0 or later. Current version: 0.9.0",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			config, _ := testPreflightClient(t, tc.appName, tc.tfeVersion, map[string]bool{
+				"agents":    true,
+				"run-tasks": false,
+				"sentinel":  false,
+			})
+
+			err := config.checkFeatureRequirements(context.Background(), tc.organization, tc.requirements)
+			if tc.err == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("expected error containing %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestCheckFeatureRequirements_cachesEntitlements(t *testing.T) {
+	config, reads := testPreflightClient(t, "HCP Terraform", "", map[string]bool{"agents": true})
+
+	requirements := featureRequirements{feature: "tfe_agent_pool", entitlements: []string{"agents"}}
+	for i := 0; i < 3; i++ {
+		if err := config.checkFeatureRequirements(context.Background(), "hashicorp", requirements); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	if *reads != 1 {
+		t.Fatalf("expected the entitlements to be read once, got %d reads", *reads)
+	}
+}
+
+func TestModifyPlanForFeatureRequirements_organization(t *testing.T) {
+	requirements := featureRequirements{feature: "tfe_organization_run_task", entitlements: []string{"run_tasks"}}
+	resourceSchema := rschema.Schema{
+		Attributes: map[string]rschema.Attribute{
+			"organization": rschema.StringAttribute{Optional: true, Computed: true},
+		},
+	}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"organization": tftypes.String}}
+	object := func(organization tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{"organization": organization})
+	}
+
+	cases := map[string]struct {
+		organization tftypes.Value
+		err          bool
+	}{
+		"provider default organization": {
+			organization: tftypes.NewValue(tftypes.String, nil),
+			err:          true,
+		},
+		"configured organization": {
+			organization: tftypes.NewValue(tftypes.String, "hashicorp"),
+			err:          true,
+		},
+		"unknown organization": {
+			organization: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			config, reads := testPreflightClient(t, "HCP Terraform", "", map[string]bool{"run_tasks": false})
+			config.Organization = "hashicorp"
+
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: resourceSchema, Raw: object(tc.organization)},
+				Plan:   tfsdk.Plan{Schema: resourceSchema, Raw: object(tftypes.NewValue(tftypes.String, tftypes.UnknownValue))},
+				State:  tfsdk.State{Schema: resourceSchema, Raw: tftypes.NewValue(objectType, nil)},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+			modifyPlanForFeatureRequirements(context.Background(), config, requirements, req, resp)
+
+			if resp.Diagnostics.HasError() != tc.err {
+				t.Fatalf("expected an error: %t, got %v", tc.err, resp.Diagnostics)
+			}
+			if !tc.err && *reads != 0 {
+				t.Fatalf("expected no entitlement reads, got %d", *reads)
+			}
+		})
+	}
+}
+
+func TestCustomizeDiffFeatureRequirements_organization(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"organization": {Type: schema.TypeString, Optional: true, Computed: true},
+		},
+		CustomizeDiff: customizeDiffFeatureRequirements(featureRequirements{feature: "tfe_agent_pool", entitlements: []string{"agents"}}),
+	}
+
+	cases := map[string]struct {
+		organization cty.Value
+		err          bool
+	}{
+		"provider default organization": {
+			organization: cty.NullVal(cty.String),
+			err:          true,
+		},
+		"configured organization": {
+			organization: cty.StringVal("hashicorp"),
+			err:          true,
+		},
+		"unknown organization": {
+			organization: cty.UnknownVal(cty.String),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			config, reads := testPreflightClient(t, "HCP Terraform", "", map[string]bool{"agents": false})
+			config.Organization = "hashicorp"
+
+			state := &terraform.InstanceState{
+				RawConfig: cty.ObjectVal(map[string]cty.Value{"organization": tc.organization}),
+			}
+			rawConfig := map[string]interface{}{}
+			if tc.organization.IsKnown() && !tc.organization.IsNull() {
+				rawConfig["organization"] = tc.organization.AsString()
+			}
+
+			_, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(rawConfig), config)
+			if (err != nil) != tc.err {
+				t.Fatalf("expected an error: %t, got %v", tc.err, err)
+			}
+			if !tc.err && *reads != 0 {
+				t.Fatalf("expected no entitlement reads, got %d", *reads)
+			}
+		})
+	}
+}
@@ -6,13 +6,16 @@ package provider
 import (
 	"context"
 	"errors"
+	"fmt"
 	"os"
+	"time"
 
 	tfe "github.com/hashicorp/go-tfe"
 	tfev2 "github.com/hashicorp/go-tfe/v2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-tfe/internal/client"
+	"github.com/hashicorp/terraform-provider-tfe/internal/provider/helpers"
 )
 
 const defaultSSLSkipVerify = false
@@ -28,6 +31,22 @@ type ConfiguredClient struct {
 	Client       *tfe.Client
 	ClientV2     *tfev2.Client
 	Organization string
+
+	// DefaultTags are the provider default tags merged into the tags of
+	// workspaces and projects.
+	DefaultTags map[string]string
+
+	// ReadOnly is true when the provider refuses every API request that is
+	// not a GET.
+	ReadOnly bool
+
+	// Lookups caches name to ID lookups. It is shared by every configuration
+	// using the same clients, and nil when skip_lookup_cache is set.
+	Lookups *client.LookupCache
+
+	// Services maps the IDs of the services discovered on the host to their
+	// URLs.
+	Services map[string]string
 }
 
 func (c ConfiguredClient) schemaOrDefaultOrganization(resource *schema.ResourceData) (string, error) {
@@ -71,9 +90,6 @@ func (c ConfiguredClient) RemoteTFEVersion() string {
 	return c.Client.RemoteTFEVersion()
 }
 
-// ctx is used as default context.Context when making TFE calls.
-var ctx = context.Background()
-
 // Provider returns a schema.Provider
 func Provider() *schema.Provider {
 	return &schema.Provider{
@@ -105,6 +121,145 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				Description: descriptions["organization"],
 			},
+
+			"read_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: descriptions["read_only"],
+			},
+
+			"skip_lookup_cache": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: descriptions["skip_lookup_cache"],
+			},
+
+			"default_tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions["default_tags"],
+			},
+
+			"ca_cert_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["ca_cert_file"],
+			},
+
+			"ca_cert_pem": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["ca_cert_pem"],
+			},
+
+			"client_cert_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["client_cert_file"],
+			},
+
+			"client_cert_pem": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["client_cert_pem"],
+			},
+
+			"client_key_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["client_key_file"],
+			},
+
+			"client_key_pem": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: descriptions["client_key_pem"],
+			},
+
+			"proxy_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["proxy_url"],
+			},
+
+			"no_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["no_proxy"],
+			},
+
+			"proxy_username": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["proxy_username"],
+			},
+
+			"proxy_password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: descriptions["proxy_password"],
+			},
+
+			"requests_per_second": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Description: descriptions["requests_per_second"],
+			},
+
+			"max_concurrent_requests": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: descriptions["max_concurrent_requests"],
+			},
+
+			"retry": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: descriptions["retry"],
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_retries": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     client.DefaultRetryConfig().MaxRetries,
+							Description: descriptions["retry.max_retries"],
+						},
+						"min_backoff": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     client.DefaultRetryConfig().MinBackoff.String(),
+							Description: descriptions["retry.min_backoff"],
+						},
+						"max_backoff": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     client.DefaultRetryConfig().MaxBackoff.String(),
+							Description: descriptions["retry.max_backoff"],
+						},
+						"retry_rate_limited": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     client.DefaultRetryConfig().RetryRateLimited,
+							Description: descriptions["retry.retry_rate_limited"],
+						},
+						"retry_server_errors": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     client.DefaultRetryConfig().RetryServerErrors,
+							Description: descriptions["retry.retry_server_errors"],
+						},
+						"deadline": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: descriptions["retry.deadline"],
+						},
+					},
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -201,6 +356,10 @@ func configure() schema.ConfigureContextFunc {
 			Client:       providerClient.TfeClient,
 			ClientV2:     providerClient.TFEClientV2,
 			Organization: providerOrganization,
+			DefaultTags:  helpers.StringMap(rd.Get("default_tags").(map[string]interface{})),
+			ReadOnly:     providerClient.ReadOnly,
+			Lookups:      providerClient.Lookups,
+			Services:     providerClient.Services,
 		}, diagnosticWarnings
 	}
 }
@@ -210,13 +369,108 @@ func configureClient(d *schema.ResourceData) (*client.ProviderClient, error) {
 	token := d.Get("token").(string)
 	insecure := d.Get("ssl_skip_verify").(bool)
 
-	return client.GetClient(hostname, token, insecure)
+	opts := client.ClientOptions{
+		Throttle: client.ThrottleConfig{
+			RequestsPerSecond:     d.Get("requests_per_second").(float64),
+			MaxConcurrentRequests: d.Get("max_concurrent_requests").(int),
+		},
+		TLS: client.TLSConfig{
+			CACertFile:     d.Get("ca_cert_file").(string),
+			CACertPEM:      d.Get("ca_cert_pem").(string),
+			ClientCertFile: d.Get("client_cert_file").(string),
+			ClientCertPEM:  d.Get("client_cert_pem").(string),
+			ClientKeyFile:  d.Get("client_key_file").(string),
+			ClientKeyPEM:   d.Get("client_key_pem").(string),
+		},
+		Proxy: client.ProxyConfig{
+			URL:      d.Get("proxy_url").(string),
+			NoProxy:  d.Get("no_proxy").(string),
+			Username: d.Get("proxy_username").(string),
+			Password: d.Get("proxy_password").(string),
+		},
+		ReadOnly:        d.Get("read_only").(bool),
+		SkipLookupCache: d.Get("skip_lookup_cache").(bool),
+	}
+	if v, ok := d.Get("retry").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		r := v[0].(map[string]interface{})
+		retry, err := parseRetryConfig(
+			r["max_retries"].(int),
+			r["min_backoff"].(string),
+			r["max_backoff"].(string),
+			r["deadline"].(string),
+			r["retry_rate_limited"].(bool),
+			r["retry_server_errors"].(bool),
+		)
+		if err != nil {
+			return nil, err
+		}
+		opts.Retry = retry
+	}
+
+	return client.GetClient(hostname, token, insecure, opts)
+}
+
+// parseRetryConfig builds the client retry policy from the values of the
+// provider's retry block. Empty duration strings keep the default values.
+func parseRetryConfig(maxRetries int, minBackoff, maxBackoff, deadline string, retryRateLimited, retryServerErrors bool) (*client.RetryConfig, error) {
+	retry := client.DefaultRetryConfig()
+	retry.MaxRetries = maxRetries
+	retry.RetryRateLimited = retryRateLimited
+	retry.RetryServerErrors = retryServerErrors
+
+	durations := []struct {
+		name   string
+		value  string
+		target *time.Duration
+	}{
+		{"min_backoff", minBackoff, &retry.MinBackoff},
+		{"max_backoff", maxBackoff, &retry.MaxBackoff},
+		{"deadline", deadline, &retry.Deadline},
+	}
+	for _, d := range durations {
+		if d.value == "" {
+			continue
+		}
+		v, err := time.ParseDuration(d.value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid retry %s %q: %w", d.name, d.value, err)
+		}
+		*d.target = v
+	}
+
+	if err := retry.Validate(); err != nil {
+		return nil, err
+	}
+	return &retry, nil
 }
 
 var descriptions = map[string]string{
 	"hostname": "The Terraform Enterprise hostname to connect to. Defaults to `app.terraform.io`. Can be overridden by setting the `TFE_HOSTNAME` environment variable.",
 	"token": "The token used to authenticate with HCP Terraform or Terraform Enterprise. We recommend omitting\n" +
 		"the token which can be set as credentials in the CLI config file. See [Authentication](#authentication) above for more information.",
-	"ssl_skip_verify": "Whether or not to skip certificate verifications. Defaults to `false`. Can be overridden setting the `TFE_SSL_SKIP_VERIFY` environment variable.",
-	"organization":    "The default organization that resources should belong to. If provided, it's usually possible to omit resource-specific `organization` arguments. Ensure that the organization already exists prior to using this argument. This can also be specified using the `TFE_ORGANIZATION` environment variable.",
+	"ssl_skip_verify":         "Whether or not to skip certificate verifications. Defaults to `false`. Can be overridden setting the `TFE_SSL_SKIP_VERIFY` environment variable.",
+	"organization":            "The default organization that resources should belong to. If provided, it's usually possible to omit resource-specific `organization` arguments. Ensure that the organization already exists prior to using this argument. This can also be specified using the `TFE_ORGANIZATION` environment variable.",
+	"skip_lookup_cache":       "Whether to disable the cache of name to ID lookups, such as finding a team or an agent pool by name. Cached lookups are reused for two minutes and dropped whenever the provider changes an object of the same type. Defaults to `false`. This can also be enabled by setting the `TFE_SKIP_LOOKUP_CACHE` environment variable.",
+	"read_only":               "Whether to refuse every API request that is not a GET, which guarantees that nothing is changed, for example when planning against a production organization. Resources that create tokens report an error at plan time and refuse to be destroyed. Defaults to `false`. This can also be enabled by setting the `TFE_READ_ONLY` environment variable.",
+	"default_tags":            "A map of key-value tags added to every `tfe_workspace`, `tfe_project` and `tfe_workspace_settings` resource managed by this provider configuration. Tags set on a resource take precedence over default tags with the same key. The merged tags are shown in the `tags_all` attribute of each resource.",
+	"ca_cert_file":            "Path to a PEM encoded bundle of certificate authorities to trust in addition to the system certificates, such as the internal CA of a Terraform Enterprise instance. Conflicts with `ca_cert_pem`. This can also be specified using the `TFE_CA_CERT_FILE` environment variable.",
+	"ca_cert_pem":             "A PEM encoded bundle of certificate authorities to trust in addition to the system certificates. Conflicts with `ca_cert_file`. This can also be specified using the `TFE_CA_CERT_PEM` environment variable.",
+	"client_cert_file":        "Path to a PEM encoded client certificate used for mutual TLS. Requires `client_key_file` or `client_key_pem`. This can also be specified using the `TFE_CLIENT_CERT_FILE` environment variable.",
+	"client_cert_pem":         "A PEM encoded client certificate used for mutual TLS. Requires `client_key_file` or `client_key_pem`. This can also be specified using the `TFE_CLIENT_CERT_PEM` environment variable.",
+	"client_key_file":         "Path to the PEM encoded private key of the client certificate. This can also be specified using the `TFE_CLIENT_KEY_FILE` environment variable.",
+	"client_key_pem":          "The PEM encoded private key of the client certificate. This can also be specified using the `TFE_CLIENT_KEY_PEM` environment variable.",
+	"proxy_url":               "The URL of the HTTP(S) proxy used to connect to HCP Terraform or Terraform Enterprise, such as `http://proxy.example.com:3128`. Unlike the `HTTPS_PROXY` environment variable, it only applies to this provider configuration. This can also be specified using the `TFE_PROXY_URL` environment variable.",
+	"no_proxy":                "A comma-separated list of hostnames, domains and CIDR ranges that are connected to directly instead of through `proxy_url`. This can also be specified using the `TFE_NO_PROXY` environment variable.",
+	"proxy_username":          "The username used to authenticate with the proxy. This can also be specified using the `TFE_PROXY_USERNAME` environment variable.",
+	"proxy_password":          "The password used to authenticate with the proxy. This can also be specified using the `TFE_PROXY_PASSWORD` environment variable.",
+	"requests_per_second":     "The maximum sustained rate of API requests per second. Requests wait for the client-side rate limiter instead of being rejected by the API. The budget is shared by all provider configurations using the same hostname and token, which use the lowest rate any of them configures. This can also be specified using the `TFE_REQUESTS_PER_SECOND` environment variable. By default requests are not rate limited.",
+	"max_concurrent_requests": "The maximum number of API requests in flight at once. The limit is shared by all provider configurations using the same hostname and token, which use the lowest limit any of them configures. This can also be specified using the `TFE_MAX_CONCURRENT_REQUESTS` environment variable. By default there is no limit.",
+	"retry":                   "Configures how API requests that were rate limited or failed with a server error are retried.",
+
+	"retry.max_retries":         "The maximum number of times a request is retried. Defaults to `10`.",
+	"retry.min_backoff":         "The minimum time to wait between retries, as a duration string such as `\"500ms\"`. The wait time doubles on each retry. Defaults to `\"100ms\"`.",
+	"retry.max_backoff":         "The maximum time to wait between retries, as a duration string such as `\"1m\"`. Defaults to `\"30s\"`.",
+	"retry.retry_rate_limited":  "Whether to retry requests that were rate limited (HTTP 429). Defaults to `true`.",
+	"retry.retry_server_errors": "Whether to retry requests that failed with a server error (HTTP 5xx) or a connection error. Connection errors of requests that are not idempotent, such as POST, are only retried when the request was never sent. Defaults to `true`.",
+	"retry.deadline":            "The total time a single request may spend being retried, as a duration string such as `\"10m\"`. By default there is no deadline.",
 }
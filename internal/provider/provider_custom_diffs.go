@@ -5,12 +5,15 @@ package provider
 
 import (
 	"context"
+	"errors"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-tfe/internal/provider/helpers"
 )
 
 func customizeDiffIfProviderDefaultOrganizationChanged(c context.Context, diff *schema.ResourceDiff, meta interface{}) error {
@@ -47,3 +50,100 @@ func modifyPlanForDefaultOrganizationChange(ctx context.Context, providerDefault
 		resp.RequiresReplace.Append(orgPath)
 	}
 }
+
+// customizeDiffDefaultTags plans tags_all as the provider default tags merged
+// with the resource tags, so that the default tags show up in the plan without
+// being part of the resource tags.
+func customizeDiffDefaultTags(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	config := meta.(ConfiguredClient)
+
+	if !diff.NewValueKnown("tags") {
+		return diff.SetNewComputed("tags_all")
+	}
+
+	tags := helpers.StringMap(diff.Get("tags").(map[string]interface{}))
+	return diff.SetNew("tags_all", helpers.MergeDefaultTags(config.DefaultTags, tags))
+}
+
+// modifyPlanForDefaultTags is the plugin framework counterpart of
+// customizeDiffDefaultTags. When the planned tags are not known yet, the
+// configured tags are used instead.
+func modifyPlanForDefaultTags(ctx context.Context, defaultTags map[string]string, configAttributes, planAttributes AttrGettable, resp *resource.ModifyPlanResponse) {
+	tagsPath := path.Root("tags")
+	tagsAllPath := path.Root("tags_all")
+
+	var tags types.Map
+	resp.Diagnostics.Append(planAttributes.GetAttribute(ctx, tagsPath, &tags)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if tags.IsUnknown() {
+		resp.Diagnostics.Append(configAttributes.GetAttribute(ctx, tagsPath, &tags)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	configured := make(map[string]string)
+	for key, value := range tags.Elements() {
+		if value.IsUnknown() {
+			tags = types.MapUnknown(types.StringType)
+			break
+		}
+		if s, ok := value.(types.String); ok && !s.IsNull() {
+			configured[key] = s.ValueString()
+		}
+	}
+	if tags.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, tagsAllPath, types.MapUnknown(types.StringType))...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, tagsAllPath, helpers.MergeDefaultTags(defaultTags, configured))...)
+}
+
+// readOnlySummary is the summary of the diagnostics reported when something
+// cannot be planned because the provider is in read-only mode.
+const readOnlySummary = "Provider is in read-only mode"
+
+// readOnlyEphemeralDetail is reported when opening an ephemeral token, which
+// creates the token during the plan.
+const readOnlyEphemeralDetail = "The provider is in read-only mode because read_only or TFE_READ_ONLY is set, so ephemeral tokens cannot be created."
+
+// errReadOnlyPlan is reported at plan time by resources that create tokens,
+// which would otherwise only fail halfway through the apply.
+var errReadOnlyPlan = errors.New("the provider is in read-only mode because read_only or TFE_READ_ONLY is set, so this resource cannot be created, changed, replaced or destroyed")
+
+// customizeDiffReadOnly fails the plan when the resource would be created or
+// changed while the provider is in read-only mode. CustomizeDiff is not called
+// for destroy plans, so the Delete functions of these resources refuse to run
+// with readOnlyDeleteDiags instead.
+func customizeDiffReadOnly(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	config := meta.(ConfiguredClient)
+
+	if config.ReadOnly && (diff.Id() == "" || len(diff.GetChangedKeysPrefix("")) > 0) {
+		return errReadOnlyPlan
+	}
+	return nil
+}
+
+// readOnlyDeleteDiags returns an error when the resource must not be destroyed
+// because the provider is in read-only mode, before any request is sent.
+func readOnlyDeleteDiags(config ConfiguredClient) diag.Diagnostics {
+	if config.ReadOnly {
+		return diag.FromErr(errReadOnlyPlan)
+	}
+	return nil
+}
+
+// modifyPlanForReadOnly is the plugin framework counterpart of
+// customizeDiffReadOnly. It also fails plans that destroy the resource.
+func modifyPlanForReadOnly(readOnly bool, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !readOnly {
+		return
+	}
+	if !req.State.Raw.IsNull() && !req.Plan.Raw.IsNull() && req.State.Raw.Equal(req.Plan.Raw) {
+		return
+	}
+	resp.Diagnostics.AddError(readOnlySummary, errReadOnlyPlan.Error())
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -15,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 type getter struct {
@@ -116,3 +118,186 @@ func TestModifyPlanForDefaultOrganizationChange(t *testing.T) {
 		})
 	}
 }
+
+type mapGetter struct {
+	val types.Map
+}
+
+func (g *mapGetter) GetAttribute(_ context.Context, _ path.Path, target interface{}) diag.Diagnostics {
+	*(target.(*basetypes.MapValue)) = g.val
+	return diag.Diagnostics{}
+}
+
+func TestModifyPlanForDefaultTags(t *testing.T) {
+	defaultTags := map[string]string{"owner": "platform", "cost-center": "42"}
+	unknownElement := types.MapValueMust(types.StringType, map[string]attr.Value{"env": types.StringUnknown()})
+
+	testCases := map[string]struct {
+		planValue   types.Map
+		configValue types.Map
+		expected    types.Map
+	}{
+		"Default tags are merged": {
+			planValue:   types.MapValueMust(types.StringType, map[string]attr.Value{"env": types.StringValue("prod")}),
+			configValue: types.MapValueMust(types.StringType, map[string]attr.Value{"env": types.StringValue("prod")}),
+			expected: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"env":         types.StringValue("prod"),
+				"owner":       types.StringValue("platform"),
+				"cost-center": types.StringValue("42"),
+			}),
+		},
+		"Resource tags win": {
+			planValue:   types.MapValueMust(types.StringType, map[string]attr.Value{"owner": types.StringValue("team-a")}),
+			configValue: types.MapValueMust(types.StringType, map[string]attr.Value{"owner": types.StringValue("team-a")}),
+			expected: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"owner":       types.StringValue("team-a"),
+				"cost-center": types.StringValue("42"),
+			}),
+		},
+		"Unknown planned tags fall back to config": {
+			planValue:   types.MapUnknown(types.StringType),
+			configValue: types.MapNull(types.StringType),
+			expected: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"owner":       types.StringValue("platform"),
+				"cost-center": types.StringValue("42"),
+			}),
+		},
+		"Unknown tag values": {
+			planValue:   unknownElement,
+			configValue: unknownElement,
+			expected:    types.MapUnknown(types.StringType),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			fakeSchema := schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"tags_all": schema.MapAttribute{
+						Computed:    true,
+						ElementType: types.StringType,
+						Description: "Test tags",
+					},
+				},
+			}
+
+			fakePlan := tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"tags_all": tftypes.Map{ElementType: tftypes.String},
+					},
+				},
+				map[string]tftypes.Value{
+					"tags_all": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tftypes.UnknownValue),
+				},
+			)
+
+			fakeResponse := &resource.ModifyPlanResponse{
+				Plan:        tfsdk.Plan{Schema: fakeSchema, Raw: fakePlan},
+				Diagnostics: diag.Diagnostics{},
+			}
+
+			c := context.TODO()
+
+			modifyPlanForDefaultTags(
+				c,
+				defaultTags,
+				&mapGetter{val: tc.configValue},
+				&mapGetter{val: tc.planValue},
+				fakeResponse,
+			)
+			if fakeResponse.Diagnostics.HasError() {
+				t.Fatalf("Expected no errors, got %v", fakeResponse.Diagnostics)
+			}
+
+			var value types.Map
+			fakeResponse.Plan.GetAttribute(c, path.Root("tags_all"), &value)
+			if !value.Equal(tc.expected) {
+				t.Fatalf("Expected tags_all to be %s, got %s", tc.expected, value)
+			}
+		})
+	}
+}
+
+func TestModifyPlanForReadOnly(t *testing.T) {
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"description": tftypes.String}}
+	value := func(description string) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"description": tftypes.NewValue(tftypes.String, description),
+		})
+	}
+	null := tftypes.NewValue(objectType, nil)
+
+	testCases := map[string]struct {
+		readOnly    bool
+		state       tftypes.Value
+		plan        tftypes.Value
+		expectError bool
+	}{
+		"Create when not read-only": {
+			state: null,
+			plan:  value("foo"),
+		},
+		"Create": {
+			readOnly:    true,
+			state:       null,
+			plan:        value("foo"),
+			expectError: true,
+		},
+		"Update": {
+			readOnly:    true,
+			state:       value("foo"),
+			plan:        value("bar"),
+			expectError: true,
+		},
+		"Destroy": {
+			readOnly:    true,
+			state:       value("foo"),
+			plan:        null,
+			expectError: true,
+		},
+		"No changes": {
+			readOnly: true,
+			state:    value("foo"),
+			plan:     value("foo"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Raw: tc.state},
+				Plan:  tfsdk.Plan{Raw: tc.plan},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+
+			modifyPlanForReadOnly(tc.readOnly, req, resp)
+
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Fatalf("Expected error to be %v, got %v", tc.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestReadOnlyDelete(t *testing.T) {
+	// The client is not configured, so any API request would panic.
+	config := ConfiguredClient{ReadOnly: true}
+
+	testCases := map[string]*sdkschema.Resource{
+		"tfe_agent_token":        resourceTFEAgentToken(),
+		"tfe_organization_token": resourceTFEOrganizationToken(),
+	}
+
+	for name, r := range testCases {
+		t.Run(name, func(t *testing.T) {
+			d := r.Data(nil)
+			d.SetId("at-123")
+
+			diags := r.DeleteWithoutTimeout(context.Background(), d, config)
+			if !diags.HasError() || diags[0].Summary != errReadOnlyPlan.Error() {
+				t.Fatalf("Expected the read-only error, got %v", diags)
+			}
+		})
+	}
+}
@@ -7,13 +7,17 @@ import (
 	"context"
 	"os"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-tfe/internal/client"
 )
@@ -30,15 +34,71 @@ var (
 	_ provider.Provider                       = &frameworkProvider{}
 	_ provider.ProviderWithEphemeralResources = &frameworkProvider{}
 	_ provider.ProviderWithActions            = &frameworkProvider{}
+	_ provider.ProviderWithFunctions          = &frameworkProvider{}
 )
 
 // FrameworkProviderConfig is a helper type for extracting the provider
 // configuration from the provider block.
 type FrameworkProviderConfig struct {
-	Hostname      types.String `tfsdk:"hostname"`
-	Token         types.String `tfsdk:"token"`
-	Organization  types.String `tfsdk:"organization"`
-	SSLSkipVerify types.Bool   `tfsdk:"ssl_skip_verify"`
+	Hostname              types.String           `tfsdk:"hostname"`
+	Token                 types.String           `tfsdk:"token"`
+	Organization          types.String           `tfsdk:"organization"`
+	ReadOnly              types.Bool             `tfsdk:"read_only"`
+	SkipLookupCache       types.Bool             `tfsdk:"skip_lookup_cache"`
+	DefaultTags           types.Map              `tfsdk:"default_tags"`
+	SSLSkipVerify         types.Bool             `tfsdk:"ssl_skip_verify"`
+	CACertFile            types.String           `tfsdk:"ca_cert_file"`
+	CACertPEM             types.String           `tfsdk:"ca_cert_pem"`
+	ClientCertFile        types.String           `tfsdk:"client_cert_file"`
+	ClientCertPEM         types.String           `tfsdk:"client_cert_pem"`
+	ClientKeyFile         types.String           `tfsdk:"client_key_file"`
+	ClientKeyPEM          types.String           `tfsdk:"client_key_pem"`
+	ProxyURL              types.String           `tfsdk:"proxy_url"`
+	NoProxy               types.String           `tfsdk:"no_proxy"`
+	ProxyUsername         types.String           `tfsdk:"proxy_username"`
+	ProxyPassword         types.String           `tfsdk:"proxy_password"`
+	RequestsPerSecond     types.Float64          `tfsdk:"requests_per_second"`
+	MaxConcurrentRequests types.Int64            `tfsdk:"max_concurrent_requests"`
+	Retry                 []FrameworkRetryConfig `tfsdk:"retry"`
+}
+
+// FrameworkRetryConfig is a helper type for extracting the retry block from
+// the provider configuration.
+type FrameworkRetryConfig struct {
+	MaxRetries        types.Int64  `tfsdk:"max_retries"`
+	MinBackoff        types.String `tfsdk:"min_backoff"`
+	MaxBackoff        types.String `tfsdk:"max_backoff"`
+	RetryRateLimited  types.Bool   `tfsdk:"retry_rate_limited"`
+	RetryServerErrors types.Bool   `tfsdk:"retry_server_errors"`
+	Deadline          types.String `tfsdk:"deadline"`
+}
+
+// toRetryConfig converts the retry block into a client retry policy, using the
+// default values for any unset arguments.
+func (r FrameworkRetryConfig) toRetryConfig() (*client.RetryConfig, error) {
+	defaults := client.DefaultRetryConfig()
+
+	maxRetries := defaults.MaxRetries
+	if !r.MaxRetries.IsNull() && !r.MaxRetries.IsUnknown() {
+		maxRetries = int(r.MaxRetries.ValueInt64())
+	}
+	retryRateLimited := defaults.RetryRateLimited
+	if !r.RetryRateLimited.IsNull() && !r.RetryRateLimited.IsUnknown() {
+		retryRateLimited = r.RetryRateLimited.ValueBool()
+	}
+	retryServerErrors := defaults.RetryServerErrors
+	if !r.RetryServerErrors.IsNull() && !r.RetryServerErrors.IsUnknown() {
+		retryServerErrors = r.RetryServerErrors.ValueBool()
+	}
+
+	return parseRetryConfig(
+		maxRetries,
+		r.MinBackoff.ValueString(),
+		r.MaxBackoff.ValueString(),
+		r.Deadline.ValueString(),
+		retryRateLimited,
+		retryServerErrors,
+	)
 }
 
 // NewFrameworkProvider is a helper function for initializing the portion of
@@ -78,10 +138,109 @@ func (p *frameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Description: descriptions["organization"],
 				Optional:    true,
 			},
+			"read_only": schema.BoolAttribute{
+				Description: descriptions["read_only"],
+				Optional:    true,
+			},
+			"skip_lookup_cache": schema.BoolAttribute{
+				Description: descriptions["skip_lookup_cache"],
+				Optional:    true,
+			},
+			"default_tags": schema.MapAttribute{
+				Description: descriptions["default_tags"],
+				Optional:    true,
+				ElementType: types.StringType,
+			},
 			"ssl_skip_verify": schema.BoolAttribute{
 				Description: descriptions["ssl_skip_verify"],
 				Optional:    true,
 			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: descriptions["ca_cert_file"],
+				Optional:    true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Description: descriptions["ca_cert_pem"],
+				Optional:    true,
+			},
+			"client_cert_file": schema.StringAttribute{
+				Description: descriptions["client_cert_file"],
+				Optional:    true,
+			},
+			"client_cert_pem": schema.StringAttribute{
+				Description: descriptions["client_cert_pem"],
+				Optional:    true,
+			},
+			"client_key_file": schema.StringAttribute{
+				Description: descriptions["client_key_file"],
+				Optional:    true,
+			},
+			"client_key_pem": schema.StringAttribute{
+				Description: descriptions["client_key_pem"],
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: descriptions["proxy_url"],
+				Optional:    true,
+			},
+			"no_proxy": schema.StringAttribute{
+				Description: descriptions["no_proxy"],
+				Optional:    true,
+			},
+			"proxy_username": schema.StringAttribute{
+				Description: descriptions["proxy_username"],
+				Optional:    true,
+			},
+			"proxy_password": schema.StringAttribute{
+				Description: descriptions["proxy_password"],
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Description: descriptions["requests_per_second"],
+				Optional:    true,
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Description: descriptions["max_concurrent_requests"],
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"retry": schema.ListNestedBlock{
+				Description: descriptions["retry"],
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_retries": schema.Int64Attribute{
+							Description: descriptions["retry.max_retries"],
+							Optional:    true,
+						},
+						"min_backoff": schema.StringAttribute{
+							Description: descriptions["retry.min_backoff"],
+							Optional:    true,
+						},
+						"max_backoff": schema.StringAttribute{
+							Description: descriptions["retry.max_backoff"],
+							Optional:    true,
+						},
+						"retry_rate_limited": schema.BoolAttribute{
+							Description: descriptions["retry.retry_rate_limited"],
+							Optional:    true,
+						},
+						"retry_server_errors": schema.BoolAttribute{
+							Description: descriptions["retry.retry_server_errors"],
+							Optional:    true,
+						},
+						"deadline": schema.StringAttribute{
+							Description: descriptions["retry.deadline"],
+							Optional:    true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -116,7 +275,46 @@ func (p *frameworkProvider) Configure(ctx context.Context, req provider.Configur
 		}
 	}
 
-	providerClient, err := client.GetClient(data.Hostname.ValueString(), data.Token.ValueString(), data.SSLSkipVerify.ValueBool())
+	defaultTags := make(map[string]string)
+	if !data.DefaultTags.IsNull() {
+		res.Diagnostics.Append(data.DefaultTags.ElementsAs(ctx, &defaultTags, false)...)
+		if res.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	opts := client.ClientOptions{
+		Throttle: client.ThrottleConfig{
+			RequestsPerSecond:     data.RequestsPerSecond.ValueFloat64(),
+			MaxConcurrentRequests: int(data.MaxConcurrentRequests.ValueInt64()),
+		},
+		TLS: client.TLSConfig{
+			CACertFile:     data.CACertFile.ValueString(),
+			CACertPEM:      data.CACertPEM.ValueString(),
+			ClientCertFile: data.ClientCertFile.ValueString(),
+			ClientCertPEM:  data.ClientCertPEM.ValueString(),
+			ClientKeyFile:  data.ClientKeyFile.ValueString(),
+			ClientKeyPEM:   data.ClientKeyPEM.ValueString(),
+		},
+		Proxy: client.ProxyConfig{
+			URL:      data.ProxyURL.ValueString(),
+			NoProxy:  data.NoProxy.ValueString(),
+			Username: data.ProxyUsername.ValueString(),
+			Password: data.ProxyPassword.ValueString(),
+		},
+		ReadOnly:        data.ReadOnly.ValueBool(),
+		SkipLookupCache: data.SkipLookupCache.ValueBool(),
+	}
+	if len(data.Retry) > 0 {
+		retry, err := data.Retry[0].toRetryConfig()
+		if err != nil {
+			res.Diagnostics.AddAttributeError(path.Root("retry"), "Invalid retry configuration", err.Error())
+			return
+		}
+		opts.Retry = retry
+	}
+
+	providerClient, err := client.GetClient(data.Hostname.ValueString(), data.Token.ValueString(), data.SSLSkipVerify.ValueBool(), opts)
 	if err != nil {
 		res.Diagnostics.AddError("Failed to initialize HTTP client", err.Error())
 		return
@@ -130,6 +328,10 @@ func (p *frameworkProvider) Configure(ctx context.Context, req provider.Configur
 		Client:       providerClient.TfeClient,
 		ClientV2:     providerClient.TFEClientV2,
 		Organization: data.Organization.ValueString(),
+		DefaultTags:  defaultTags,
+		ReadOnly:     providerClient.ReadOnly,
+		Lookups:      providerClient.Lookups,
+		Services:     providerClient.Services,
 	}
 
 	res.DataSourceData = configuredClient
@@ -142,6 +344,18 @@ func (p *frameworkProvider) Configure(ctx context.Context, req provider.Configur
 func (p *frameworkProvider) Actions(ctx context.Context) []func() action.Action {
 	return []func() action.Action{
 		NewQueryRunAction,
+		NewRunAction,
+		NewWorkspaceLockAction,
+		NewWorkspaceUnlockAction,
+		NewRunsCleanupAction,
+	}
+}
+
+func (p *frameworkProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewIsResourceIDFunction,
+		NewParseWorkspaceIDFunction,
+		NewRegistryModuleSourceFunction,
 	}
 }
 
@@ -152,6 +366,7 @@ func (p *frameworkProvider) DataSources(ctx context.Context) []func() datasource
 		NewHYOKCustomerKeyVersionDataSource,
 		NewHYOKEncryptedDataKeyDataSource,
 		NewIPRangesDataSource,
+		NewInstanceDataSource,
 		NewNoCodeModuleDataSource,
 		NewOrgMaxTokenTTLPolicyDataSource,
 		NewOrganizationAuditConfigurationDataSource,
@@ -172,6 +387,10 @@ func (p *frameworkProvider) DataSources(ctx context.Context) []func() datasource
 		NewSCIMSettingsDataSource,
 		NewSCIMTokenDataSource,
 		NewSCIMGroupDataSource,
+		NewRunDataSource,
+		NewRunsDataSource,
+		NewPlanJSONDataSource,
+		NewWorkspaceResourcesDataSource,
 	}
 }
 
@@ -216,6 +435,7 @@ func (p *frameworkProvider) Resources(ctx context.Context) []func() resource.Res
 		NewSCIMSettingsResource,
 		NewSCIMTokenResource,
 		NewSCIMGroupMappingResource,
+		NewStateVersionResource,
 	}
 }
 
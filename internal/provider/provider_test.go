@@ -204,7 +204,7 @@ func getProviderClientUsingEnv() (*client.ProviderClient, error) {
 	}
 	token := os.Getenv("TFE_TOKEN")
 
-	providerClient, err := client.GetClient(hostname, token, defaultSSLSkipVerify)
+	providerClient, err := client.GetClient(hostname, token, defaultSSLSkipVerify, client.ClientOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("Error getting client: %w", err)
 	}
@@ -233,7 +233,7 @@ func getClientWithToken(token string) (*tfe.Client, error) {
 		hostname = os.Getenv("TFE_HOSTNAME")
 	}
 
-	providerClient, err := client.GetClient(hostname, token, defaultSSLSkipVerify)
+	providerClient, err := client.GetClient(hostname, token, defaultSSLSkipVerify, client.ClientOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("Error getting client: %w", err)
 	}
@@ -473,3 +473,26 @@ var envGithubWorkspaceIdentifier string
 var envGithubWorkspaceBranch string
 var envTFEUser1 string
 var envTFEUser2 string
+
+func Test_parseRetryConfig(t *testing.T) {
+	retry, err := parseRetryConfig(3, "250ms", "", "5m", false, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if retry.MaxRetries != 3 || retry.MinBackoff != 250*time.Millisecond || retry.Deadline != 5*time.Minute {
+		t.Fatalf("unexpected retry config: %+v", retry)
+	}
+	if retry.MaxBackoff != client.DefaultRetryConfig().MaxBackoff {
+		t.Fatalf("expected default max backoff, got %s", retry.MaxBackoff)
+	}
+	if retry.RetryRateLimited || !retry.RetryServerErrors {
+		t.Fatalf("unexpected retry flags: %+v", retry)
+	}
+
+	if _, err := parseRetryConfig(3, "soon", "", "", true, true); err == nil || !strings.Contains(err.Error(), "invalid retry min_backoff") {
+		t.Fatalf("expected invalid duration error, got %v", err)
+	}
+	if _, err := parseRetryConfig(3, "1m", "1s", "", true, true); err == nil {
+		t.Fatal("expected an error when max_backoff is less than min_backoff")
+	}
+}
@@ -17,6 +17,7 @@ import (
 
 	tfev2 "github.com/hashicorp/go-tfe/v2"
 	"github.com/hashicorp/go-tfe/v2/api/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-tfe/internal/provider/helpers"
 )
@@ -26,15 +27,24 @@ func resourceTFEAgentPool() *schema.Resource {
 		Description: "Manages agent pools." +
 			"\n\nAn agent pool represents a group of agents, often related to one another by sharing a common network segment or purpose. A workspace may be configured to use one of the organization's agent pools to run remote operations with isolated, private, or on-premises infrastructure.",
 
-		Create: resourceTFEAgentPoolCreate,
-		Read:   resourceTFEAgentPoolRead,
-		Update: resourceTFEAgentPoolUpdate,
-		Delete: resourceTFEAgentPoolDelete,
+		CreateWithoutTimeout: resourceTFEAgentPoolCreate,
+		ReadWithoutTimeout:   resourceTFEAgentPoolRead,
+		UpdateWithoutTimeout: resourceTFEAgentPoolUpdate,
+		DeleteWithoutTimeout: resourceTFEAgentPoolDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFEAgentPoolImporter,
 		},
 
-		CustomizeDiff: customizeDiffIfProviderDefaultOrganizationChanged,
+		CustomizeDiff: func(c context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if err := customizeDiffIfProviderDefaultOrganizationChanged(c, d, meta); err != nil {
+				return err
+			}
+
+			return customizeDiffFeatureRequirements(featureRequirements{
+				feature:      "tfe_agent_pool",
+				entitlements: []string{"agents"},
+			})(c, d, meta)
+		},
 
 		Identity: &schema.ResourceIdentity{
 			SchemaFunc: func() map[string]*schema.Schema {
@@ -82,14 +92,14 @@ func resourceTFEAgentPool() *schema.Resource {
 	}
 }
 
-func resourceTFEAgentPoolCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEAgentPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the name and organization.
 	name := d.Get("name").(string)
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Build the v2 request body.
@@ -107,13 +117,13 @@ func resourceTFEAgentPoolCreate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Create new agent pool for organization: %s", organization)
 	env, err := config.ClientV2.API.Organizations().ByOrganization_name(organization).AgentPools().Post(ctx, body, nil)
 	if err != nil {
-		return fmt.Errorf(
-			"Error creating agent pool %s for organization %s: %w", name, organization, err)
+		return diag.Errorf(
+			"Error creating agent pool %s for organization %s: %s", name, organization, err)
 	}
 
 	agentPool := env.GetData()
 	if agentPool == nil {
-		return fmt.Errorf("Error creating agent pool %s for organization %s: API returned no data", name, organization)
+		return diag.Errorf("Error creating agent pool %s for organization %s: API returned no data", name, organization)
 	}
 
 	poolID := valueOrZero(agentPool.GetId())
@@ -123,13 +133,13 @@ func resourceTFEAgentPoolCreate(d *schema.ResourceData, meta interface{}) error
 
 	err = helpers.WriteTFEIdentity(d, poolID, config.Client.BaseURL().Host)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTFEAgentPoolRead(d, meta)
+	return resourceTFEAgentPoolRead(ctx, d, meta)
 }
 
-func resourceTFEAgentPoolRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEAgentPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read configuration of agent pool: %s", d.Id())
@@ -140,7 +150,7 @@ func resourceTFEAgentPoolRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading configuration of agent pool %s: %w", d.Id(), err)
+		return diag.Errorf("Error reading configuration of agent pool %s: %s", d.Id(), err)
 	}
 
 	agentPool := env.GetData()
@@ -163,7 +173,7 @@ func resourceTFEAgentPoolRead(d *schema.ResourceData, meta interface{}) error {
 
 	err = helpers.WriteTFEIdentity(d, d.Id(), config.Client.BaseURL().Host)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -190,7 +200,7 @@ func organizationNameFromAgentPoolRelationships(rels models.AgentPools_relations
 	return ""
 }
 
-func resourceTFEAgentPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEAgentPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Build the v2 request body.
@@ -209,13 +219,13 @@ func resourceTFEAgentPoolUpdate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Update agent pool: %s", d.Id())
 	_, err := config.ClientV2.API.AgentPools().ByAgent_pool_id(d.Id()).Patch(ctx, body, nil)
 	if err != nil {
-		return fmt.Errorf("Error updating agent pool %s: %w", d.Id(), err)
+		return diag.Errorf("Error updating agent pool %s: %s", d.Id(), err)
 	}
 
-	return resourceTFEAgentPoolRead(d, meta)
+	return resourceTFEAgentPoolRead(ctx, d, meta)
 }
 
-func resourceTFEAgentPoolDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEAgentPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Delete agent pool: %s", d.Id())
@@ -224,7 +234,7 @@ func resourceTFEAgentPoolDelete(d *schema.ResourceData, meta interface{}) error
 		if errors.Is(err, tfev2.ErrNotFound) {
 			return nil
 		}
-		return fmt.Errorf("Error deleting agent pool %s: %w", d.Id(), err)
+		return diag.Errorf("Error deleting agent pool %s: %s", d.Id(), err)
 	}
 
 	return nil
@@ -235,7 +245,7 @@ func resourceTFEAgentPoolImporter(ctx context.Context, d *schema.ResourceData, m
 
 	if d.Id() != "" {
 		// Import using the import prefix instead of identity
-		return resourceTFEAgentPoolImporterLegacy(d, config)
+		return resourceTFEAgentPoolImporterLegacy(ctx, d, config)
 	}
 
 	// We are using an identity
@@ -249,7 +259,7 @@ func resourceTFEAgentPoolImporter(ctx context.Context, d *schema.ResourceData, m
 	return []*schema.ResourceData{d}, nil
 }
 
-func resourceTFEAgentPoolImporterLegacy(d *schema.ResourceData, cfg ConfiguredClient) ([]*schema.ResourceData, error) {
+func resourceTFEAgentPoolImporterLegacy(ctx context.Context, d *schema.ResourceData, cfg ConfiguredClient) ([]*schema.ResourceData, error) {
 	s := strings.Split(d.Id(), "/")
 	if len(s) >= 3 {
 		return nil, fmt.Errorf(
@@ -259,7 +269,7 @@ func resourceTFEAgentPoolImporterLegacy(d *schema.ResourceData, cfg ConfiguredCl
 	} else if len(s) == 2 {
 		org := s[0]
 		poolName := s[1]
-		pool, err := fetchAgentPool(org, poolName, cfg.ClientV2)
+		pool, err := cfg.lookupAgentPool(ctx, org, poolName)
 		if err != nil {
 			return nil, fmt.Errorf(
 				"error retrieving agent pool with name %s from organization %s %w", poolName, org, err)
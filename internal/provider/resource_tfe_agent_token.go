@@ -9,12 +9,13 @@
 package provider
 
 import (
+	"context"
 	"errors"
-	"fmt"
 	"log"
 
 	tfev2 "github.com/hashicorp/go-tfe/v2"
 	"github.com/hashicorp/go-tfe/v2/api/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -23,9 +24,11 @@ func resourceTFEAgentToken() *schema.Resource {
 		Description: "Manages agent tokens." +
 			"\n\nEach agent pool has its own set of tokens which are not shared across pools. These tokens allow agents to communicate securely with HCP Terraform.",
 
-		Create: resourceTFEAgentTokenCreate,
-		Read:   resourceTFEAgentTokenRead,
-		Delete: resourceTFEAgentTokenDelete,
+		CreateWithoutTimeout: resourceTFEAgentTokenCreate,
+		ReadWithoutTimeout:   resourceTFEAgentTokenRead,
+		DeleteWithoutTimeout: resourceTFEAgentTokenDelete,
+
+		CustomizeDiff: customizeDiffReadOnly,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -56,7 +59,7 @@ func resourceTFEAgentToken() *schema.Resource {
 	}
 }
 
-func resourceTFEAgentTokenCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEAgentTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the agent pool ID
@@ -78,12 +81,12 @@ func resourceTFEAgentTokenCreate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Create new agent token for agent pool ID: %s", agentPoolID)
 	env, err := config.ClientV2.API.AgentPools().ByAgent_pool_id(agentPoolID).AuthenticationTokens().Post(ctx, body, nil)
 	if err != nil {
-		return fmt.Errorf("Error creating agent token for agent pool ID %s: %w", agentPoolID, err)
+		return diag.Errorf("Error creating agent token for agent pool ID %s: %s", agentPoolID, err)
 	}
 
 	agentToken := env.GetData()
 	if agentToken == nil {
-		return fmt.Errorf("Error creating agent token for agent pool ID %s: API returned no data", agentPoolID)
+		return diag.Errorf("Error creating agent token for agent pool ID %s: API returned no data", agentPoolID)
 	}
 
 	d.SetId(valueOrZero(agentToken.GetId()))
@@ -94,10 +97,10 @@ func resourceTFEAgentTokenCreate(d *schema.ResourceData, meta interface{}) error
 		d.Set("token", valueOrZero(attrs.GetToken()))
 	}
 
-	return resourceTFEAgentTokenRead(d, meta)
+	return resourceTFEAgentTokenRead(ctx, d, meta)
 }
 
-func resourceTFEAgentTokenRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEAgentTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read configuration of agent token: %s", d.Id())
@@ -108,7 +111,7 @@ func resourceTFEAgentTokenRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading configuration of agent token %s: %w", d.Id(), err)
+		return diag.Errorf("Error reading configuration of agent token %s: %s", d.Id(), err)
 	}
 
 	agentToken := env.GetData()
@@ -126,8 +129,11 @@ func resourceTFEAgentTokenRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceTFEAgentTokenDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEAgentTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
+	if diags := readOnlyDeleteDiags(config); diags.HasError() {
+		return diags
+	}
 
 	log.Printf("[DEBUG] Delete agent token: %s", d.Id())
 	err := config.ClientV2.API.AuthenticationTokens().ById(d.Id()).Delete(ctx, nil)
@@ -135,7 +141,7 @@ func resourceTFEAgentTokenDelete(d *schema.ResourceData, meta interface{}) error
 		if errors.Is(err, tfev2.ErrNotFound) {
 			return nil
 		}
-		return fmt.Errorf("Error deleting agent token %s: %w", d.Id(), err)
+		return diag.Errorf("Error deleting agent token %s: %s", d.Id(), err)
 	}
 
 	return nil
@@ -72,6 +72,7 @@ func (r *resourceAuditTrailToken) Metadata(_ context.Context, req resource.Metad
 func (r *resourceAuditTrailToken) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	// If an audit trail token uses the default organization, then if the default org. changes, it should trigger a modification
 	modifyPlanForDefaultOrganizationChange(ctx, r.config.Organization, req.State, req.Config, req.Plan, resp)
+	modifyPlanForReadOnly(r.config.ReadOnly, req, resp)
 }
 
 func (r *resourceAuditTrailToken) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
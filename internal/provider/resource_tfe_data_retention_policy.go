@@ -332,7 +332,7 @@ func (r *resourceTFEDataRetentionPolicy) ImportState(ctx context.Context, req re
 	}
 
 	if len(s) == 2 {
-		workspaceID, err := fetchWorkspaceExternalID(s[0]+"/"+s[1], r.config.Client)
+		workspaceID, err := r.config.lookupWorkspaceExternalID(ctx, s[0]+"/"+s[1])
 		if err != nil {
 			resp.Diagnostics.AddError("Error importing data retention policy", fmt.Sprintf(
 				"error retrieving workspace with name %s from organization %s: %s", s[1], s[0], err.Error(),
@@ -33,6 +33,11 @@ func resourceTFENoCodeModule() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		CustomizeDiff: customizeDiffIfProviderDefaultOrganizationChanged,
 
 		Schema: map[string]*schema.Schema{
@@ -129,7 +134,7 @@ func resourceTFENoCodeModuleCreate(ctx context.Context, d *schema.ResourceData,
 		if err != nil {
 			return diag.Errorf("Error getting full module ID for registry module %s: %s", options.RegistryModule.ID, err)
 		}
-		if err := waitForModuleVersion(ctx, config.Client, moduleID, options.VersionPin); err != nil {
+		if err := waitForModuleVersion(ctx, config.Client, moduleID, options.VersionPin, d.Timeout(schema.TimeoutCreate)); err != nil {
 			return diag.Errorf("Error reading registry module version %s: %s", options.VersionPin, err)
 		}
 	}
@@ -185,9 +190,8 @@ func getFullModuleID(ctx context.Context, client *tfe.Client, orgName, id string
 	}, nil
 }
 
-func waitForModuleVersion(ctx context.Context, client *tfe.Client, moduleID tfe.RegistryModuleID, versionPin string) error {
-	timeout := time.Duration(5) * time.Minute
-	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+func waitForModuleVersion(ctx context.Context, client *tfe.Client, moduleID tfe.RegistryModuleID, versionPin string, timeout time.Duration) error {
+	err := retryUntilTimeout(ctx, timeout, func() *retry.RetryError {
 		_, err := client.RegistryModules.ReadVersion(ctx, moduleID, versionPin)
 		if errors.Is(err, tfe.ErrResourceNotFound) {
 			return retry.RetryableError(fmt.Errorf("version %s not found for module %s", versionPin, moduleID))
@@ -197,6 +201,10 @@ func waitForModuleVersion(ctx context.Context, client *tfe.Client, moduleID tfe.
 		}
 		return nil
 	})
+	if isTimeoutError(err) {
+		return fmt.Errorf("timed out after %s waiting for version %s of module %s/%s to be published: %w", timeout, versionPin, moduleID.Organization, moduleID.Name, err)
+	}
+	return err
 }
 
 func resourceTFENoCodeModuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -222,13 +230,16 @@ func resourceTFENoCodeModuleUpdate(ctx context.Context, d *schema.ResourceData,
 		options.VariableOptions = variableOptionsMaptoStruct(variableOptions.([]interface{}))
 	}
 
-	err = retry.RetryContext(ctx, time.Duration(5)*time.Minute, func() *retry.RetryError {
+	err = retryUntilTimeout(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
 		noCodeModule, err = config.Client.RegistryNoCodeModules.Update(ctx, d.Id(), options)
 		if err != nil {
 			return retry.RetryableError(err)
 		}
 		return nil
 	})
+	if isTimeoutError(err) && options.VersionPin != "" {
+		return diag.Errorf("timed out after %s waiting for no-code module %s to be updated to version %s: %s", d.Timeout(schema.TimeoutUpdate), d.Id(), options.VersionPin, err)
+	}
 	if err != nil {
 		return diag.Errorf("Error while waiting for no-code module %s to be updated: %s", d.Id(), err)
 	}
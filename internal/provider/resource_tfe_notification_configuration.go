@@ -67,7 +67,7 @@ type modelTFENotificationConfiguration struct {
 
 // modelFromTFENotificationConfiguration builds a modelTFENotificationConfiguration struct from a v2 notification configuration resource.
 // lastTokenValue carries forward the previously known token (never returned by the API) so it isn't lost from state.
-func modelFromTFENotificationConfiguration(v models.NotificationConfigurationsable, tokenWOVersion, urlWOVersion types.Int64, lastTokenValue types.String) (modelTFENotificationConfiguration, diag.Diagnostics) {
+func modelFromTFENotificationConfiguration(ctx context.Context, v models.NotificationConfigurationsable, tokenWOVersion, urlWOVersion types.Int64, lastTokenValue types.String) (modelTFENotificationConfiguration, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	result := modelTFENotificationConfiguration{
 		ID:              types.StringValue(valueOrZero(v.GetId())),
@@ -718,7 +718,7 @@ func (r *resourceTFENotificationConfiguration) Create(ctx context.Context, req r
 	// Restore token from plan because it is write only; modelFromTFENotificationConfiguration
 	// nulls it back out below when token_wo is in use.
 	// We got a notification, so set state to new values
-	result, diags := modelFromTFENotificationConfiguration(nc, plan.TokenWOVersion, plan.URLWOVersion, plan.Token)
+	result, diags := modelFromTFENotificationConfiguration(ctx, nc, plan.TokenWOVersion, plan.URLWOVersion, plan.Token)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -761,7 +761,7 @@ func (r *resourceTFENotificationConfiguration) Read(ctx context.Context, req res
 
 	// Restore token from state because it is write only; modelFromTFENotificationConfiguration
 	// nulls it back out below when token_wo is in use.
-	result, diags := modelFromTFENotificationConfiguration(ncEnvelope.GetData(), state.TokenWOVersion, state.URLWOVersion, state.Token)
+	result, diags := modelFromTFENotificationConfiguration(ctx, ncEnvelope.GetData(), state.TokenWOVersion, state.URLWOVersion, state.Token)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -862,7 +862,7 @@ func (r *resourceTFENotificationConfiguration) Update(ctx context.Context, req r
 
 	// Restore token from plan because it is write only; modelFromTFENotificationConfiguration
 	// nulls it back out below when token_wo is in use.
-	result, diags := modelFromTFENotificationConfiguration(nc, plan.TokenWOVersion, plan.URLWOVersion, plan.Token)
+	result, diags := modelFromTFENotificationConfiguration(ctx, nc, plan.TokenWOVersion, plan.URLWOVersion, plan.Token)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -406,7 +406,7 @@ func (r *OPAVersionResource) ImportState(ctx context.Context, req resource.Impor
 	// determines if the string is a tool version ID
 	s := strings.Split(req.ID, "-")
 	if s[0] != "tool" {
-		versionID, err := fetchOPAVersionID(req.ID, r.config.Client)
+		versionID, err := fetchOPAVersionID(ctx, req.ID, r.config.Client)
 		tflog.Debug(ctx, "Importing OPA version", map[string]interface{}{
 			"version_id": versionID,
 		})
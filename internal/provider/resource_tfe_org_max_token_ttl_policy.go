@@ -186,6 +186,11 @@ func (r *resourceTFEOrgMaxTokenTTLPolicy) ModifyPlan(ctx context.Context, req re
 	if req.Plan.Raw.IsNull() {
 		return
 	}
+
+	modifyPlanForFeatureRequirements(ctx, r.config, featureRequirements{
+		feature:       "tfe_org_max_token_ttl_policy",
+		minTFEVersion: minTFEVersionOrgMaxTokenTTLPolicy,
+	}, req, resp)
 }
 
 func (r *resourceTFEOrgMaxTokenTTLPolicy) checkMaxTokenTTLPolicySupport() error {
@@ -9,11 +9,12 @@
 package provider
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -22,10 +23,10 @@ func resourceTFEOrganization() *schema.Resource {
 	return &schema.Resource{
 		Description: "Manages organizations.",
 
-		Create: resourceTFEOrganizationCreate,
-		Read:   resourceTFEOrganizationRead,
-		Update: resourceTFEOrganizationUpdate,
-		Delete: resourceTFEOrganizationDelete,
+		CreateWithoutTimeout: resourceTFEOrganizationCreate,
+		ReadWithoutTimeout:   resourceTFEOrganizationRead,
+		UpdateWithoutTimeout: resourceTFEOrganizationUpdate,
+		DeleteWithoutTimeout: resourceTFEOrganizationDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -156,7 +157,7 @@ func resourceTFEOrganization() *schema.Resource {
 	}
 }
 
-func resourceTFEOrganizationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOrganizationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the organization name.
@@ -171,15 +172,15 @@ func resourceTFEOrganizationCreate(d *schema.ResourceData, meta interface{}) err
 	log.Printf("[DEBUG] Create new organization: %s", name)
 	org, err := config.Client.Organizations.Create(ctx, options)
 	if err != nil {
-		return fmt.Errorf("Error creating the new organization %s: %w", name, err)
+		return diag.Errorf("Error creating the new organization %s: %s", name, err)
 	}
 
 	d.SetId(org.Name)
 
-	return resourceTFEOrganizationUpdate(d, meta)
+	return resourceTFEOrganizationUpdate(ctx, d, meta)
 }
 
-func resourceTFEOrganizationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOrganizationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read configuration of organization: %s", d.Id())
@@ -190,7 +191,7 @@ func resourceTFEOrganizationRead(d *schema.ResourceData, meta interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Update the config.
@@ -223,7 +224,7 @@ func resourceTFEOrganizationRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceTFEOrganizationUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOrganizationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Create a new options struct.
@@ -307,15 +308,15 @@ func resourceTFEOrganizationUpdate(d *schema.ResourceData, meta interface{}) err
 	log.Printf("[DEBUG] Update configuration of organization: %s", d.Id())
 	org, err := config.Client.Organizations.Update(ctx, d.Id(), options)
 	if err != nil {
-		return fmt.Errorf("Error updating organization %s: %w", d.Id(), err)
+		return diag.Errorf("Error updating organization %s: %s", d.Id(), err)
 	}
 
 	d.SetId(org.Name)
 
-	return resourceTFEOrganizationRead(d, meta)
+	return resourceTFEOrganizationRead(ctx, d, meta)
 }
 
-func resourceTFEOrganizationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOrganizationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Delete organization: %s", d.Id())
@@ -324,7 +325,7 @@ func resourceTFEOrganizationDelete(d *schema.ResourceData, meta interface{}) err
 		if err == tfe.ErrResourceNotFound {
 			return nil
 		}
-		return fmt.Errorf("Error deleting organization %s: %w", d.Id(), err)
+		return diag.Errorf("Error deleting organization %s: %s", d.Id(), err)
 	}
 
 	return nil
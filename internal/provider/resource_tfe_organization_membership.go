@@ -15,6 +15,7 @@ import (
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-tfe/internal/provider/helpers"
 )
@@ -25,9 +26,9 @@ func resourceTFEOrganizationMembership() *schema.Resource {
 			"\n\n~> **Note:** This resource requires using the provider with HCP Terraform or Terraform Enterprise at least as recent as v202004-1." +
 			"\n\n~> **Note:** This resource cannot be used to update an existing user's email address since users themselves are the only ones permitted to update their email address. If a user updates their email address, configurations using the email address should be updated manually.",
 
-		Create: resourceTFEOrganizationMembershipCreate,
-		Read:   resourceTFEOrganizationMembershipRead,
-		Delete: resourceTFEOrganizationMembershipDelete,
+		CreateWithoutTimeout: resourceTFEOrganizationMembershipCreate,
+		ReadWithoutTimeout:   resourceTFEOrganizationMembershipRead,
+		DeleteWithoutTimeout: resourceTFEOrganizationMembershipDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFEOrganizationMembershipImporter,
 		},
@@ -86,14 +87,14 @@ func resourceTFEOrganizationMembership() *schema.Resource {
 	}
 }
 
-func resourceTFEOrganizationMembershipCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOrganizationMembershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the email and organization.
 	email := d.Get("email").(string)
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Create a new options struct.
@@ -104,21 +105,21 @@ func resourceTFEOrganizationMembershipCreate(d *schema.ResourceData, meta interf
 	log.Printf("[DEBUG] Create membership %s for organization: %s", email, organization)
 	membership, err := config.Client.OrganizationMemberships.Create(ctx, organization, options)
 	if err != nil {
-		return fmt.Errorf(
-			"Error creating membership %s for organization %s: %w", email, organization, err)
+		return diag.Errorf(
+			"Error creating membership %s for organization %s: %s", email, organization, err)
 	}
 
 	d.SetId(membership.ID)
 
 	err = helpers.WriteTFEIdentity(d, membership.ID, config.Client.BaseURL().Host)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTFEOrganizationMembershipRead(d, meta)
+	return resourceTFEOrganizationMembershipRead(ctx, d, meta)
 }
 
-func resourceTFEOrganizationMembershipRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOrganizationMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	options := tfe.OrganizationMembershipReadOptions{
@@ -134,7 +135,7 @@ func resourceTFEOrganizationMembershipRead(d *schema.ResourceData, meta interfac
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading configuration of membership %s: %w", d.Id(), err)
+		return diag.Errorf("Error reading configuration of membership %s: %s", d.Id(), err)
 	}
 
 	d.Set("email", membership.Email)
@@ -144,13 +145,13 @@ func resourceTFEOrganizationMembershipRead(d *schema.ResourceData, meta interfac
 
 	err = helpers.WriteTFEIdentity(d, membership.ID, config.Client.BaseURL().Host)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceTFEOrganizationMembershipDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOrganizationMembershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Delete membership: %s", d.Id())
@@ -159,7 +160,7 @@ func resourceTFEOrganizationMembershipDelete(d *schema.ResourceData, meta interf
 		if err == tfe.ErrResourceNotFound {
 			return nil
 		}
-		return fmt.Errorf("Error deleting membership %s: %w", d.Id(), err)
+		return diag.Errorf("Error deleting membership %s: %s", d.Id(), err)
 	}
 
 	return nil
@@ -192,7 +193,7 @@ func resourceTFEOrganizationMembershipImporter(ctx context.Context, d *schema.Re
 	if len(s) == 2 {
 		org := s[0]
 		email := s[1]
-		orgMembership, err := fetchOrganizationMemberByNameOrEmail(ctx, config.Client, org, "", email)
+		orgMembership, err := config.lookupOrganizationMemberByNameOrEmail(ctx, org, "", email)
 		if err != nil {
 			return nil, fmt.Errorf(
 				"error retrieving user with email %s from organization %s: %w", email, org, err)
@@ -17,11 +17,12 @@
 package provider
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -30,11 +31,11 @@ func resourceTFEOrganizationModuleSharing() *schema.Resource {
 		Description: "(Only for Terraform Enterprise) Manages module sharing for an organization." +
 			"\n\n-> **Note:** This resource requires an admin token. `tfe_admin_organization_settings` also manages global module sharing, and these resources are mutually exclusive.",
 
-		DeprecationMessage: "The `tfe_organization_module_sharing` resource is deprecated. Use `tfe_admin_organization_settings` instead, which allows the management of the global module sharing setting. They attempt to manage the same resource and are mutually exclusive.",
-		Create:             resourceTFEOrganizationModuleSharingCreate,
-		Read:               resourceTFEOrganizationModuleSharingRead,
-		Update:             resourceTFEOrganizationModuleSharingUpdate,
-		Delete:             resourceTFEOrganizationModuleSharingDelete,
+		DeprecationMessage:   "The `tfe_organization_module_sharing` resource is deprecated. Use `tfe_admin_organization_settings` instead, which allows the management of the global module sharing setting. They attempt to manage the same resource and are mutually exclusive.",
+		CreateWithoutTimeout: resourceTFEOrganizationModuleSharingCreate,
+		ReadWithoutTimeout:   resourceTFEOrganizationModuleSharingRead,
+		UpdateWithoutTimeout: resourceTFEOrganizationModuleSharingUpdate,
+		DeleteWithoutTimeout: resourceTFEOrganizationModuleSharingDelete,
 
 		CustomizeDiff: customizeDiffIfProviderDefaultOrganizationChanged,
 
@@ -66,22 +67,22 @@ func resourceTFEOrganizationModuleSharing() *schema.Resource {
 	}
 }
 
-func resourceTFEOrganizationModuleSharingCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOrganizationModuleSharingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get the organization name that will share "produce" modules
 	config := meta.(ConfiguredClient)
 
 	producer, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] Create %s module consumers", producer)
 	d.SetId(producer)
 
-	return resourceTFEOrganizationModuleSharingUpdate(d, meta)
+	return resourceTFEOrganizationModuleSharingUpdate(ctx, d, meta)
 }
 
-func resourceTFEOrganizationModuleSharingUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOrganizationModuleSharingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	var consumers []string
@@ -96,13 +97,13 @@ func resourceTFEOrganizationModuleSharingUpdate(d *schema.ResourceData, meta int
 	log.Printf("[DEBUG] Update %s module consumers", d.Id())
 	err := config.Client.Admin.Organizations.UpdateModuleConsumers(ctx, d.Id(), consumers)
 	if err != nil {
-		return fmt.Errorf("error updating module consumers to %s: %w", d.Id(), err)
+		return diag.Errorf("error updating module consumers to %s: %s", d.Id(), err)
 	}
 
-	return resourceTFEOrganizationModuleSharingRead(d, meta)
+	return resourceTFEOrganizationModuleSharingRead(ctx, d, meta)
 }
 
-func resourceTFEOrganizationModuleSharingRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOrganizationModuleSharingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	options := &tfe.AdminOrganizationListModuleConsumersOptions{}
@@ -116,7 +117,7 @@ func resourceTFEOrganizationModuleSharingRead(d *schema.ResourceData, meta inter
 				d.SetId("")
 				return nil
 			}
-			return fmt.Errorf("Error reading organization %s module consumer list: %w", d.Id(), err)
+			return diag.Errorf("Error reading organization %s module consumer list: %s", d.Id(), err)
 		}
 
 		if consumerList.CurrentPage >= consumerList.TotalPages {
@@ -129,7 +130,7 @@ func resourceTFEOrganizationModuleSharingRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTFEOrganizationModuleSharingDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOrganizationModuleSharingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Disable module sharing for organization: %s", d.Id())
@@ -138,7 +139,7 @@ func resourceTFEOrganizationModuleSharingDelete(d *schema.ResourceData, meta int
 		if err == tfe.ErrResourceNotFound {
 			return nil
 		}
-		return fmt.Errorf("failed to delete module sharing for organization %s: %w", d.Id(), err)
+		return diag.Errorf("failed to delete module sharing for organization %s: %s", d.Id(), err)
 	}
 
 	return nil
@@ -143,6 +143,11 @@ func (r *resourceOrgRunTask) Metadata(_ context.Context, req resource.MetadataRe
 func (r *resourceOrgRunTask) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	// If a Run Tasks uses the default organization, then if the deafault org. changes, it should trigger a modification
 	modifyPlanForDefaultOrganizationChange(ctx, r.config.Organization, req.State, req.Config, req.Plan, resp)
+
+	modifyPlanForFeatureRequirements(ctx, r.config, featureRequirements{
+		feature:      "tfe_organization_run_task",
+		entitlements: []string{"run_tasks"},
+	}, req, resp)
 }
 
 // Configure implements resource.ResourceWithConfigure
@@ -411,7 +416,7 @@ func (r *resourceOrgRunTask) ImportState(ctx context.Context, req resource.Impor
 	taskName := s[1]
 	orgName := s[0]
 
-	if task, err := fetchOrganizationRunTaskV2(taskName, orgName, r.config.ClientV2); err != nil {
+	if task, err := fetchOrganizationRunTaskV2(ctx, taskName, orgName, r.config.ClientV2); err != nil {
 		resp.Diagnostics.AddError(
 			"Error importing organization run task",
 			err.Error(),
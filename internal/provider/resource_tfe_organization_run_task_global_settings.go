@@ -186,7 +186,7 @@ func (r *resourceOrganizationRunTaskGlobalSettings) Read(ctx context.Context, re
 		return
 	}
 
-	result := dataModelFromTFEOrganizationRunTaskGlobalSettingsV2(task)
+	result := dataModelFromTFEOrganizationRunTaskGlobalSettingsV2(ctx, task)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
@@ -239,7 +239,7 @@ func (r *resourceOrganizationRunTaskGlobalSettings) updateRunTask(ctx context.Co
 		diagnostics.AddError("Unable to update organization task", "No task data was returned by the API")
 		return
 	}
-	result := dataModelFromTFEOrganizationRunTaskGlobalSettingsV2(taskEnvelope.GetData())
+	result := dataModelFromTFEOrganizationRunTaskGlobalSettingsV2(ctx, taskEnvelope.GetData())
 
 	diagnostics.Append(tfState.Set(ctx, &result)...)
 }
@@ -280,7 +280,7 @@ func (r *resourceOrganizationRunTaskGlobalSettings) ImportState(ctx context.Cont
 	taskName := s[1]
 	orgName := s[0]
 
-	if task, err := fetchOrganizationRunTaskV2(taskName, orgName, r.config.ClientV2); err != nil {
+	if task, err := fetchOrganizationRunTaskV2(ctx, taskName, orgName, r.config.ClientV2); err != nil {
 		resp.Diagnostics.AddError(
 			"Error importing organization run task",
 			err.Error(),
@@ -292,7 +292,7 @@ func (r *resourceOrganizationRunTaskGlobalSettings) ImportState(ctx context.Cont
 		)
 	} else {
 		// We can never import the HMACkey (Write-only) so assume it's the default (empty)
-		result := dataModelFromTFEOrganizationRunTaskGlobalSettingsV2(task)
+		result := dataModelFromTFEOrganizationRunTaskGlobalSettingsV2(ctx, task)
 		resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
 	}
 }
@@ -11,12 +11,12 @@ package provider
 import (
 	"context"
 	"errors"
-	"fmt"
 	"log"
 	"time"
 
 	tfe "github.com/hashicorp/go-tfe/v2"
 	"github.com/hashicorp/go-tfe/v2/api/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -24,14 +24,20 @@ func resourceTFEOrganizationToken() *schema.Resource {
 	return &schema.Resource{
 		Description: "Generates a new organization token, replacing any existing token, which can be used to act as the organization service account.",
 
-		Create: resourceTFEOrganizationTokenCreate,
-		Read:   resourceTFEOrganizationTokenRead,
-		Delete: resourceTFEOrganizationTokenDelete,
+		CreateWithoutTimeout: resourceTFEOrganizationTokenCreate,
+		ReadWithoutTimeout:   resourceTFEOrganizationTokenRead,
+		DeleteWithoutTimeout: resourceTFEOrganizationTokenDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFEOrganizationTokenImporter,
 		},
 
-		CustomizeDiff: customizeDiffIfProviderDefaultOrganizationChanged,
+		CustomizeDiff: func(c context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if err := customizeDiffIfProviderDefaultOrganizationChanged(c, d, meta); err != nil {
+				return err
+			}
+
+			return customizeDiffReadOnly(c, d, meta)
+		},
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -88,13 +94,13 @@ func newOrganizationTokenEnvelope(expiry *time.Time) *models.AuthenticationToken
 	return envelope
 }
 
-func resourceTFEOrganizationTokenCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOrganizationTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the organization name.
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Issue warning if expired_at is not provided
@@ -105,13 +111,13 @@ func resourceTFEOrganizationTokenCreate(d *schema.ResourceData, meta interface{}
 	log.Printf("[DEBUG] Check if a token already exists for organization: %s", organization)
 	_, err = config.ClientV2.API.Organizations().ByOrganization_name(organization).AuthenticationToken().Get(ctx, nil)
 	if err != nil && !errors.Is(err, tfe.ErrNotFound) {
-		return fmt.Errorf("error checking if a token exists for organization %s: %w", organization, err)
+		return diag.Errorf("error checking if a token exists for organization %s: %s", organization, err)
 	}
 
 	// If error is nil, the token already exists.
 	if err == nil {
 		if !d.Get("force_regenerate").(bool) {
-			return fmt.Errorf("a token already exists for organization: %s", organization)
+			return diag.Errorf("a token already exists for organization: %s", organization)
 		}
 		log.Printf("[DEBUG] Regenerating existing token for organization: %s", organization)
 	}
@@ -124,7 +130,7 @@ func resourceTFEOrganizationTokenCreate(d *schema.ResourceData, meta interface{}
 	if expiredAtProvided {
 		parsed, err := time.Parse(time.RFC3339, expiredAt.(string))
 		if err != nil {
-			return fmt.Errorf("%s must be a valid date or time, provided in iso8601 format", expiredAt)
+			return diag.Errorf("%s must be a valid date or time, provided in iso8601 format", expiredAt)
 		}
 		expiry = &parsed
 	}
@@ -133,11 +139,11 @@ func resourceTFEOrganizationTokenCreate(d *schema.ResourceData, meta interface{}
 
 	tokenEnvelope, err := config.ClientV2.API.Organizations().ByOrganization_name(organization).AuthenticationToken().Post(ctx, envelope, nil)
 	if err != nil {
-		return fmt.Errorf(
-			"error creating new token for organization %s: %w", organization, err)
+		return diag.Errorf(
+			"error creating new token for organization %s: %s", organization, err)
 	}
 	if tokenEnvelope == nil || tokenEnvelope.GetData() == nil {
-		return fmt.Errorf("error creating new token for organization %s: no data was returned by the API", organization)
+		return diag.Errorf("error creating new token for organization %s: no data was returned by the API", organization)
 	}
 	token := tokenEnvelope.GetData()
 
@@ -151,10 +157,10 @@ func resourceTFEOrganizationTokenCreate(d *schema.ResourceData, meta interface{}
 			d.Set("expired_at", expiredAt.Format(time.RFC3339))
 		}
 	}
-	return resourceTFEOrganizationTokenRead(d, meta)
+	return resourceTFEOrganizationTokenRead(ctx, d, meta)
 }
 
-func resourceTFEOrganizationTokenRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOrganizationTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read the token from organization: %s", d.Id())
@@ -165,7 +171,7 @@ func resourceTFEOrganizationTokenRead(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error reading token from organization %s: %w", d.Id(), err)
+		return diag.Errorf("error reading token from organization %s: %s", d.Id(), err)
 	}
 	if tokenEnvelope == nil || tokenEnvelope.GetData() == nil {
 		log.Printf("[DEBUG] Token for organization %s no longer exists", d.Id())
@@ -183,13 +189,16 @@ func resourceTFEOrganizationTokenRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTFEOrganizationTokenDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEOrganizationTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
+	if diags := readOnlyDeleteDiags(config); diags.HasError() {
+		return diags
+	}
 
 	// Get the organization name.
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] Delete token from organization: %s", organization)
@@ -198,7 +207,7 @@ func resourceTFEOrganizationTokenDelete(d *schema.ResourceData, meta interface{}
 		if errors.Is(err, tfe.ErrNotFound) {
 			return nil
 		}
-		return fmt.Errorf("error deleting token from organization %s: %w", d.Id(), err)
+		return diag.Errorf("error deleting token from organization %s: %s", d.Id(), err)
 	}
 
 	return nil
@@ -16,6 +16,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-tfe/internal/provider/helpers"
@@ -27,10 +28,10 @@ func resourceTFEPolicy() *schema.Resource {
 			"\n\nPolicies are rules enforced on Terraform runs. You can use policies to validate that the Terraform plan complies with security rules and best practices. Two policy-as-code frameworks are integrated with Terraform Enterprise: Sentinel and Open Policy Agent (OPA)." +
 			"\n\nPolicies are configured on a per-organization level and are organized and grouped into policy sets, which define the workspaces on which policies are enforced during runs.",
 
-		Create: resourceTFEPolicyCreate,
-		Read:   resourceTFEPolicyRead,
-		Update: resourceTFEPolicyUpdate,
-		Delete: resourceTFEPolicyDelete,
+		CreateWithoutTimeout: resourceTFEPolicyCreate,
+		ReadWithoutTimeout:   resourceTFEPolicyRead,
+		UpdateWithoutTimeout: resourceTFEPolicyUpdate,
+		DeleteWithoutTimeout: resourceTFEPolicyDelete,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFEPolicyImporter,
@@ -156,14 +157,14 @@ func opaPolicyEnforcementLevels() []string {
 	}
 }
 
-func resourceTFEPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the name and organization.
 	name := d.Get("name").(string)
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var kind string
@@ -192,30 +193,30 @@ func resourceTFEPolicyCreate(d *schema.ResourceData, meta interface{}) error {
 			"unsupported policy kind %s: has to be one of [%s, %s]", kind, string(tfe.Sentinel), string(tfe.OPA))
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	log.Printf("[DEBUG] Create %s policy %s for organization: %s", kind, name, organization)
 	policy, err := config.Client.Policies.Create(ctx, organization, *options)
 	if err != nil {
-		return fmt.Errorf(
-			"Error creating %s policy %s for organization %s: %w", kind, name, organization, err)
+		return diag.Errorf(
+			"Error creating %s policy %s for organization %s: %s", kind, name, organization, err)
 	}
 
 	d.SetId(policy.ID)
 
 	err = helpers.WriteTFEIdentityWithOrg(d, policy.ID, organization, config.Client.BaseURL().Host)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] Upload %s policy %s for organization: %s", kind, name, organization)
 	err = config.Client.Policies.Upload(ctx, policy.ID, []byte(d.Get("policy").(string)))
 	if err != nil {
-		return fmt.Errorf(
-			"Error uploading %s policy %s for organization %s: %w", kind, name, organization, err)
+		return diag.Errorf(
+			"Error uploading %s policy %s for organization %s: %s", kind, name, organization, err)
 	}
 
-	return resourceTFEPolicyRead(d, meta)
+	return resourceTFEPolicyRead(ctx, d, meta)
 }
 
 func createOPAPolicyOptions(options *tfe.PolicyCreateOptions, d *schema.ResourceData) (*tfe.PolicyCreateOptions, error) {
@@ -272,7 +273,7 @@ func getDefaultEnforcementMode(kind tfe.PolicyKind) tfe.EnforcementLevel {
 	}
 }
 
-func resourceTFEPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read policy: %s", d.Id())
@@ -283,7 +284,7 @@ func resourceTFEPolicyRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading Policy %s: %w", d.Id(), err)
+		return diag.Errorf("Error reading Policy %s: %s", d.Id(), err)
 	}
 
 	// Update the config.
@@ -298,24 +299,24 @@ func resourceTFEPolicyRead(d *schema.ResourceData, meta interface{}) error {
 
 	content, err := config.Client.Policies.Download(ctx, policy.ID)
 	if err != nil {
-		return fmt.Errorf("Error downloading policy %s: %w", d.Id(), err)
+		return diag.Errorf("Error downloading policy %s: %s", d.Id(), err)
 	}
 	d.Set("policy", string(content))
 
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = helpers.WriteTFEIdentityWithOrg(d, policy.ID, organization, config.Client.BaseURL().Host)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceTFEPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	var kind string
@@ -353,8 +354,8 @@ func resourceTFEPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[DEBUG] Update configuration for %s policy: %s", kind, d.Id())
 		_, err := config.Client.Policies.Update(ctx, d.Id(), options)
 		if err != nil {
-			return fmt.Errorf(
-				"Error updating configuration for %s policy %s: %w", kind, d.Id(), err)
+			return diag.Errorf(
+				"Error updating configuration for %s policy %s: %s", kind, d.Id(), err)
 		}
 	}
 
@@ -363,14 +364,14 @@ func resourceTFEPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[DEBUG] Update %s policy: %s", vKind, d.Id())
 		err := config.Client.Policies.Upload(ctx, d.Id(), []byte(d.Get("policy").(string)))
 		if err != nil {
-			return fmt.Errorf("Error updating %s policy %s: %w", vKind, d.Id(), err)
+			return diag.Errorf("Error updating %s policy %s: %s", vKind, d.Id(), err)
 		}
 	}
 
-	return resourceTFEPolicyRead(d, meta)
+	return resourceTFEPolicyRead(ctx, d, meta)
 }
 
-func resourceTFEPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Delete policy: %s", d.Id())
@@ -379,7 +380,7 @@ func resourceTFEPolicyDelete(d *schema.ResourceData, meta interface{}) error {
 		if errors.Is(err, tfe.ErrResourceNotFound) {
 			return nil
 		}
-		return fmt.Errorf("Error deleting policy %s: %w", d.Id(), err)
+		return diag.Errorf("Error deleting policy %s: %s", d.Id(), err)
 	}
 
 	return nil
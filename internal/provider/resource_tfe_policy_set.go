@@ -9,11 +9,15 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-tfe/internal/provider/helpers"
@@ -26,15 +30,29 @@ func resourceTFEPolicySet() *schema.Resource {
 			"\n\nPolicy sets are groups of policies that are applied together to related workspaces. By using policy sets, you can group your policies by attributes such as environment or region. Individual policies that are members of policy sets will only be checked for workspaces that the policy set is attached to." +
 			"\n\n-> **Note:** When neither `vcs_repo` nor `policy_ids` is specified, the default behavior is to create an empty non-VCS policy set.",
 
-		Create: resourceTFEPolicySetCreate,
-		Read:   resourceTFEPolicySetRead,
-		Update: resourceTFEPolicySetUpdate,
-		Delete: resourceTFEPolicySetDelete,
+		CreateWithoutTimeout: resourceTFEPolicySetCreate,
+		ReadWithoutTimeout:   resourceTFEPolicySetRead,
+		UpdateWithoutTimeout: resourceTFEPolicySetUpdate,
+		DeleteWithoutTimeout: resourceTFEPolicySetDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughWithIdentity("id"),
 		},
 
-		CustomizeDiff: customizeDiffIfProviderDefaultOrganizationChanged,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: func(c context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if err := customizeDiffIfProviderDefaultOrganizationChanged(c, d, meta); err != nil {
+				return err
+			}
+
+			return customizeDiffFeatureRequirements(featureRequirements{
+				feature:      "tfe_policy_set",
+				entitlements: []string{"sentinel"},
+			})(c, d, meta)
+		},
 
 		Identity: &schema.ResourceIdentity{
 			SchemaFunc: func() map[string]*schema.Schema {
@@ -213,13 +231,13 @@ func resourceTFEPolicySet() *schema.Resource {
 	}
 }
 
-func resourceTFEPolicySetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEPolicySetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	name := d.Get("name").(string)
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Create a new options struct.
@@ -293,15 +311,15 @@ func resourceTFEPolicySetCreate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Create policy set %s for organization: %s", name, organization)
 	policySet, err := config.Client.PolicySets.Create(ctx, organization, options)
 	if err != nil {
-		return fmt.Errorf(
-			"Error creating policy set %s for organization %s: %w", name, organization, err)
+		return diag.Errorf(
+			"Error creating policy set %s for organization %s: %s", name, organization, err)
 	}
 	_, hasVCSRepo := d.GetOk("vcs_repo")
 	_, hasSlug := d.GetOk("slug")
 	if hasSlug && !hasVCSRepo {
-		err := resourceTFEPolicySetUploadVersion(config.Client, d, policySet.ID)
+		err := resourceTFEPolicySetUploadVersion(ctx, config.Client, d, policySet.ID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -309,13 +327,13 @@ func resourceTFEPolicySetCreate(d *schema.ResourceData, meta interface{}) error
 
 	err = helpers.WriteTFEIdentity(d, policySet.ID, config.Client.BaseURL().Host)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTFEPolicySetRead(d, meta)
+	return resourceTFEPolicySetRead(ctx, d, meta)
 }
 
-func resourceTFEPolicySetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEPolicySetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read policy set: %s", d.Id())
@@ -326,7 +344,7 @@ func resourceTFEPolicySetRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading policy set %s: %w", d.Id(), err)
+		return diag.Errorf("Error reading policy set %s: %s", d.Id(), err)
 	}
 
 	// Update the config.
@@ -397,13 +415,13 @@ func resourceTFEPolicySetRead(d *schema.ResourceData, meta interface{}) error {
 
 	err = helpers.WriteTFEIdentity(d, policySet.ID, config.Client.BaseURL().Host)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceTFEPolicySetUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEPolicySetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	name := d.Get("name").(string)
@@ -426,7 +444,7 @@ func resourceTFEPolicySetUpdate(d *schema.ResourceData, meta interface{}) error
 			log.Printf("[DEBUG] Removing previous workspaces from now-global policy set: %s", d.Id())
 			err := config.Client.PolicySets.RemoveWorkspaces(ctx, d.Id(), options)
 			if err != nil {
-				return fmt.Errorf("Error detaching policy set %s from workspaces: %w", d.Id(), err)
+				return diag.Errorf("Error detaching policy set %s from workspaces: %s", d.Id(), err)
 			}
 		}
 	}
@@ -500,8 +518,8 @@ func resourceTFEPolicySetUpdate(d *schema.ResourceData, meta interface{}) error
 		log.Printf("[DEBUG] Update configuration for policy set: %s", d.Id())
 		_, err := config.Client.PolicySets.Update(ctx, d.Id(), options)
 		if err != nil {
-			return fmt.Errorf(
-				"Error updating configuration for policy set %s: %w", d.Id(), err)
+			return diag.Errorf(
+				"Error updating configuration for policy set %s: %s", d.Id(), err)
 		}
 	}
 
@@ -521,7 +539,7 @@ func resourceTFEPolicySetUpdate(d *schema.ResourceData, meta interface{}) error
 			log.Printf("[DEBUG] Add policies to policy set: %s", d.Id())
 			err := config.Client.PolicySets.AddPolicies(ctx, d.Id(), options)
 			if err != nil {
-				return fmt.Errorf("Error adding policies to policy set %s: %w", d.Id(), err)
+				return diag.Errorf("Error adding policies to policy set %s: %s", d.Id(), err)
 			}
 		}
 
@@ -536,16 +554,16 @@ func resourceTFEPolicySetUpdate(d *schema.ResourceData, meta interface{}) error
 			log.Printf("[DEBUG] Remove policies from policy set: %s", d.Id())
 			err := config.Client.PolicySets.RemovePolicies(ctx, d.Id(), options)
 			if err != nil {
-				return fmt.Errorf("Error removing policies from policy set %s: %w", d.Id(), err)
+				return diag.Errorf("Error removing policies from policy set %s: %s", d.Id(), err)
 			}
 		}
 	}
 
 	_, hasVCSRepo := d.GetOk("vcs_repo")
 	if d.HasChange("slug") && !hasVCSRepo {
-		err := resourceTFEPolicySetUploadVersion(config.Client, d, d.Id())
+		err := resourceTFEPolicySetUploadVersion(ctx, config.Client, d, d.Id(), d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -568,7 +586,7 @@ func resourceTFEPolicySetUpdate(d *schema.ResourceData, meta interface{}) error
 			log.Printf("[DEBUG] Attach policy set to workspaces: %s", d.Id())
 			err := config.Client.PolicySets.AddWorkspaces(ctx, d.Id(), options)
 			if err != nil {
-				return fmt.Errorf("Error attaching policy set %s to workspaces: %w", d.Id(), err)
+				return diag.Errorf("Error attaching policy set %s to workspaces: %s", d.Id(), err)
 			}
 		}
 
@@ -583,15 +601,15 @@ func resourceTFEPolicySetUpdate(d *schema.ResourceData, meta interface{}) error
 			log.Printf("[DEBUG] Detach policy set from workspaces: %s", d.Id())
 			err := config.Client.PolicySets.RemoveWorkspaces(ctx, d.Id(), options)
 			if err != nil {
-				return fmt.Errorf("Error detaching policy set %s from workspaces: %w", d.Id(), err)
+				return diag.Errorf("Error detaching policy set %s from workspaces: %s", d.Id(), err)
 			}
 		}
 	}
 
-	return resourceTFEPolicySetRead(d, meta)
+	return resourceTFEPolicySetRead(ctx, d, meta)
 }
 
-func resourceTFEPolicySetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEPolicySetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Delete policy set: %s", d.Id())
@@ -600,13 +618,15 @@ func resourceTFEPolicySetDelete(d *schema.ResourceData, meta interface{}) error
 		if err == tfe.ErrResourceNotFound {
 			return nil
 		}
-		return fmt.Errorf("Error deleting policy set %s: %w", d.Id(), err)
+		return diag.Errorf("Error deleting policy set %s: %s", d.Id(), err)
 	}
 
 	return nil
 }
 
-func resourceTFEPolicySetUploadVersion(client *tfe.Client, d *schema.ResourceData, policySetID string) error {
+// resourceTFEPolicySetUploadVersion uploads the policies of the slug as a new
+// policy set version and waits until the version is ingested.
+func resourceTFEPolicySetUploadVersion(ctx context.Context, client *tfe.Client, d *schema.ResourceData, policySetID string, timeout time.Duration) error {
 	log.Printf("[DEBUG] Create policy set version for policy set %s.", policySetID)
 	psv, err := client.PolicySetVersions.Create(ctx, policySetID)
 	if err != nil {
@@ -622,5 +642,27 @@ func resourceTFEPolicySetUploadVersion(client *tfe.Client, d *schema.ResourceDat
 		return fmt.Errorf("Error uploading policies for policy set version %s: %w", psv.ID, err)
 	}
 
-	return nil
+	status := psv.Status
+	err = retryUntilTimeout(ctx, timeout, func() *retry.RetryError {
+		log.Printf("[DEBUG] Read policy set version %s.", psv.ID)
+		version, err := client.PolicySetVersions.Read(ctx, psv.ID)
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("Error reading policy set version %s: %w", psv.ID, err))
+		}
+
+		status = version.Status
+		switch status {
+		case tfe.PolicySetVersionReady:
+			return nil
+		case tfe.PolicySetVersionErrored:
+			return retry.NonRetryableError(fmt.Errorf("Error ingressing policy set version %s: %s", psv.ID, version.ErrorMessage))
+		default:
+			return retry.RetryableError(fmt.Errorf("policy set version %s is %s", psv.ID, status))
+		}
+	})
+	if isTimeoutError(err) {
+		return fmt.Errorf("timed out after %s waiting for policy set version %s to be ingressed, status is %s", timeout, psv.ID, status)
+	}
+
+	return err
 }
@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-tfe/internal/provider/helpers"
 )
 
 var (
@@ -51,6 +52,7 @@ type modelTFEProject struct {
 	Organization                types.String `tfsdk:"organization"`
 	AutoDestroyActivityDuration types.String `tfsdk:"auto_destroy_activity_duration"`
 	Tags                        types.Map    `tfsdk:"tags"`
+	TagsAll                     types.Map    `tfsdk:"tags_all"`
 	IgnoreAdditionalTags        types.Bool   `tfsdk:"ignore_additional_tags"`
 }
 
@@ -62,8 +64,9 @@ type modelProjectIdentity struct {
 // modelFromTFEProject builds a modelTFEProject struct from a v2 project resource. tags is a plain
 // key/value map since its two callers source it differently: Create/Update echo back the tags
 // just sent (trusting local input), while Read sources it from the server's effective tag
-// bindings via GET /projects/{id}/effective-tag-bindings.
-func modelFromTFEProject(p models.Projectsable, tags map[string]string, ignoreAdditionalTags types.Bool) modelTFEProject {
+// bindings via GET /projects/{id}/effective-tag-bindings. tagsAll additionally includes the
+// provider default tags.
+func modelFromTFEProject(p models.Projectsable, tags, tagsAll map[string]string, ignoreAdditionalTags types.Bool) modelTFEProject {
 	model := modelTFEProject{
 		ID:                   types.StringValue(valueOrZero(p.GetId())),
 		Organization:         types.StringValue(projectOrganizationID(p.GetRelationships())),
@@ -78,17 +81,23 @@ func modelFromTFEProject(p models.Projectsable, tags map[string]string, ignoreAd
 		}
 	}
 
+	model.Tags = projectTagsValue(tags)
+	model.TagsAll = projectTagsValue(tagsAll)
+
+	return model
+}
+
+// projectTagsValue converts a plain key/value map into a tags map value.
+func projectTagsValue(tags map[string]string) types.Map {
 	tagElems := make(map[string]attr.Value, len(tags))
 	for key, value := range tags {
 		tagElems[key] = types.StringValue(value)
 	}
-	model.Tags = types.MapValueMust(types.StringType, tagElems)
-
-	return model
+	return types.MapValueMust(types.StringType, tagElems)
 }
 
-// projectPlanTagBindings extracts the configured tag key/value pairs from a plan's tags map.
-func projectPlanTagBindings(tags types.Map) map[string]string {
+// planTagBindings extracts the configured tag key/value pairs from a plan's tags map.
+func planTagBindings(tags types.Map) map[string]string {
 	bindings := make(map[string]string)
 	for key, val := range tags.Elements() {
 		if strVal, ok := val.(types.String); ok && !strVal.IsNull() {
@@ -241,6 +250,12 @@ func (r *resourceTFEProject) Schema(ctx context.Context, req resource.SchemaRequ
 				Description: "Explicitly ignores `tags` not defined by config so they will not be overwritten by the configured tags. This creates exceptional behaviour in Terraform with respect to `tags` and is not recommended. This value must be applied before it will be used.",
 				Optional:    true,
 			},
+
+			"tags_all": schema.MapAttribute{
+				Description: "A map of key-value tags set on the project, including the provider `default_tags`.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
 		},
 	}
 }
@@ -281,7 +296,8 @@ func (r *resourceTFEProject) Create(ctx context.Context, req resource.CreateRequ
 		autoDestroy = &v
 	}
 
-	tagBindings := projectPlanTagBindings(plan.Tags)
+	tags := planTagBindings(plan.Tags)
+	tagBindings := helpers.MergeDefaultTags(r.config.DefaultTags, tags)
 
 	envelope := newProjectCreateEnvelope(name, plan.Description.ValueString(), autoDestroy)
 
@@ -306,7 +322,7 @@ func (r *resourceTFEProject) Create(ctx context.Context, req resource.CreateRequ
 		}
 	}
 
-	result := modelFromTFEProject(projectData, tagBindings, plan.IgnoreAdditionalTags)
+	result := modelFromTFEProject(projectData, tags, tagBindings, plan.IgnoreAdditionalTags)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
 
@@ -363,16 +379,20 @@ func (r *resourceTFEProject) Read(ctx context.Context, req resource.ReadRequest,
 		}
 	}
 
+	// The tags managed by this resource include the provider default tags, which are reported
+	// in tags_all but left out of tags.
+	configuredTags := planTagBindings(state.Tags)
 	if state.IgnoreAdditionalTags.ValueBool() {
-		currentTags := state.Tags.Elements()
+		managedTags := helpers.MergeDefaultTags(planTagBindings(state.TagsAll), configuredTags)
 		for key := range tagBindings {
-			if _, ok := currentTags[key]; !ok {
+			if _, ok := managedTags[key]; !ok {
 				delete(tagBindings, key)
 			}
 		}
 	}
+	tags := helpers.RemoveDefaultTags(r.config.DefaultTags, tagBindings, configuredTags)
 
-	result := modelFromTFEProject(projectData, tagBindings, state.IgnoreAdditionalTags)
+	result := modelFromTFEProject(projectData, tags, tagBindings, state.IgnoreAdditionalTags)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
 
@@ -483,7 +503,8 @@ func (r *resourceTFEProject) Update(ctx context.Context, req resource.UpdateRequ
 	// Tag bindings always go through the dedicated /projects/{id}/relationships/tag-bindings
 	// endpoint now; go-tfe/v2 has no way to embed them in the same request as the attributes
 	// update above.
-	tagBindings := projectPlanTagBindings(plan.Tags)
+	tags := planTagBindings(plan.Tags)
+	tagBindings := helpers.MergeDefaultTags(r.config.DefaultTags, tags)
 	if len(tagBindings) > 0 || !plan.IgnoreAdditionalTags.ValueBool() {
 		collection := newTagBindingsCollection(tagBindings)
 		if err := r.config.ClientV2.API.Projects().ByProject_id(id).Relationships().TagBindings().Patch(ctx, collection, nil); err != nil {
@@ -507,7 +528,7 @@ func (r *resourceTFEProject) Update(ctx context.Context, req resource.UpdateRequ
 		projectData = projEnvelope.GetData()
 	}
 
-	result := modelFromTFEProject(projectData, tagBindings, plan.IgnoreAdditionalTags)
+	result := modelFromTFEProject(projectData, tags, tagBindings, plan.IgnoreAdditionalTags)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
 
@@ -552,6 +573,7 @@ func (r *resourceTFEProject) ModifyPlan(ctx context.Context, req resource.Modify
 	}
 
 	modifyPlanForDefaultOrganizationChange(ctx, r.config.Organization, req.State, req.Config, req.Plan, resp)
+	modifyPlanForDefaultTags(ctx, r.config.DefaultTags, req.Config, req.Plan, resp)
 }
 
 func (r *resourceTFEProject) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
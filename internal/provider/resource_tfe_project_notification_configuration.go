@@ -68,7 +68,7 @@ type modelTFEProjectNotificationConfiguration struct {
 
 // modelFromTFEProjectNotificationConfiguration builds a modelTFEProjectNotificationConfiguration
 // struct from a v2 notification configuration resource.
-func modelFromTFEProjectNotificationConfiguration(v models.NotificationConfigurationsable, tokenWOVersion types.Int64, urlWOVersion types.Int64, lastValue types.String, priorTriggers types.Set) (*modelTFEProjectNotificationConfiguration, diag.Diagnostics) {
+func modelFromTFEProjectNotificationConfiguration(ctx context.Context, v models.NotificationConfigurationsable, tokenWOVersion types.Int64, urlWOVersion types.Int64, lastValue types.String, priorTriggers types.Set) (*modelTFEProjectNotificationConfiguration, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	result := modelTFEProjectNotificationConfiguration{
 		ID:             types.StringValue(valueOrZero(v.GetId())),
@@ -448,7 +448,7 @@ func (r *resourceTFEProjectNotificationConfiguration) Create(ctx context.Context
 		return
 	}
 
-	result, diags := modelFromTFEProjectNotificationConfiguration(pnc, config.TokenWOVersion, plan.URLWOVersion, lastTokenValue, plan.Triggers)
+	result, diags := modelFromTFEProjectNotificationConfiguration(ctx, pnc, config.TokenWOVersion, plan.URLWOVersion, lastTokenValue, plan.Triggers)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
@@ -489,7 +489,7 @@ func (r *resourceTFEProjectNotificationConfiguration) Read(ctx context.Context,
 		return
 	}
 
-	result, diags := modelFromTFEProjectNotificationConfiguration(pncEnvelope.GetData(), state.TokenWOVersion, state.URLWOVersion, state.Token, state.Triggers)
+	result, diags := modelFromTFEProjectNotificationConfiguration(ctx, pncEnvelope.GetData(), state.TokenWOVersion, state.URLWOVersion, state.Token, state.Triggers)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
@@ -598,7 +598,7 @@ func (r *resourceTFEProjectNotificationConfiguration) Update(ctx context.Context
 		return
 	}
 
-	result, diags := modelFromTFEProjectNotificationConfiguration(pnc, config.TokenWOVersion, plan.URLWOVersion, lastTokenValue, plan.Triggers)
+	result, diags := modelFromTFEProjectNotificationConfiguration(ctx, pnc, config.TokenWOVersion, plan.URLWOVersion, lastTokenValue, plan.Triggers)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
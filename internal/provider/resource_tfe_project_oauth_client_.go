@@ -11,15 +11,16 @@ import (
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceTFEProjectOAuthClient() *schema.Resource {
 	return &schema.Resource{
-		Description: "Adds and removes OAuth clients from a project.",
-		Create:      resourceTFEProjectOauthClientCreate,
-		Read:        resourceTFEProjectOauthClientRead,
-		Delete:      resourceTFEProjectOauthClientDelete,
+		Description:          "Adds and removes OAuth clients from a project.",
+		CreateWithoutTimeout: resourceTFEProjectOauthClientCreate,
+		ReadWithoutTimeout:   resourceTFEProjectOauthClientRead,
+		DeleteWithoutTimeout: resourceTFEProjectOauthClientDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFEProjectOauthClientImporter,
 		},
@@ -48,7 +49,7 @@ func resourceTFEProjectOAuthClient() *schema.Resource {
 	}
 }
 
-func resourceTFEProjectOauthClientCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEProjectOauthClientCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	oauthClientID := d.Get("oauth_client_id").(string)
@@ -59,16 +60,16 @@ func resourceTFEProjectOauthClientCreate(d *schema.ResourceData, meta interface{
 
 	err := config.Client.OAuthClients.AddProjects(ctx, oauthClientID, oauthClientAddProjectsOptions)
 	if err != nil {
-		return fmt.Errorf(
-			"error attaching oauth client id %s to project %s: %w", oauthClientID, projectID, err)
+		return diag.Errorf(
+			"error attaching oauth client id %s to project %s: %s", oauthClientID, projectID, err)
 	}
 
 	d.SetId(fmt.Sprintf("%s_%s", projectID, oauthClientID))
 
-	return resourceTFEProjectOauthClientRead(d, meta)
+	return resourceTFEProjectOauthClientRead(ctx, d, meta)
 }
 
-func resourceTFEProjectOauthClientRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEProjectOauthClientRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	oauthClientID := d.Get("oauth_client_id").(string)
@@ -84,7 +85,7 @@ func resourceTFEProjectOauthClientRead(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error reading configuration of oauth client %s: %w", oauthClientID, err)
+		return diag.Errorf("error reading configuration of oauth client %s: %s", oauthClientID, err)
 	}
 
 	isProjectAttached := false
@@ -106,7 +107,7 @@ func resourceTFEProjectOauthClientRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTFEProjectOauthClientDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEProjectOauthClientDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	oauthClientID := d.Get("oauth_client_id").(string)
@@ -118,8 +119,8 @@ func resourceTFEProjectOauthClientDelete(d *schema.ResourceData, meta interface{
 
 	err := config.Client.OAuthClients.RemoveProjects(ctx, oauthClientID, oauthClientRemoveProjectsOptions)
 	if err != nil {
-		return fmt.Errorf(
-			"error detaching project %s from oauth client %s: %w", projectID, oauthClientID, err)
+		return diag.Errorf(
+			"error detaching project %s from oauth client %s: %s", projectID, oauthClientID, err)
 	}
 
 	return nil
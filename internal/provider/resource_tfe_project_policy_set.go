@@ -16,6 +16,7 @@ import (
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -26,9 +27,9 @@ func resourceTFEProjectPolicySet() *schema.Resource {
 			"\n\nPolicy sets are groups of policies that are applied together to related workspaces. By using policy sets, you can group your policies by attributes such as environment or region. Individual policies that are members of policy sets will only be checked for workspaces that the policy set is attached to." +
 			"\n\n~> **Note:** Tag-based scoping and explicit workspace/project associations are mutually exclusive on a policy set. To switch between them, first remove the existing association (`terraform apply`), then add the new one (`terraform apply`).",
 
-		Create: resourceTFEProjectPolicySetCreate,
-		Read:   resourceTFEProjectPolicySetRead,
-		Delete: resourceTFEProjectPolicySetDelete,
+		CreateWithoutTimeout: resourceTFEProjectPolicySetCreate,
+		ReadWithoutTimeout:   resourceTFEProjectPolicySetRead,
+		DeleteWithoutTimeout: resourceTFEProjectPolicySetDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFEProjectPolicySetImporter,
 		},
@@ -57,7 +58,7 @@ func resourceTFEProjectPolicySet() *schema.Resource {
 	}
 }
 
-func resourceTFEProjectPolicySetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEProjectPolicySetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	policySetID := d.Get("policy_set_id").(string)
@@ -68,16 +69,16 @@ func resourceTFEProjectPolicySetCreate(d *schema.ResourceData, meta interface{})
 
 	err := config.Client.PolicySets.AddProjects(ctx, policySetID, policySetAddProjectsOptions)
 	if err != nil {
-		return fmt.Errorf(
-			"error attaching policy set id %s to project %s: %w", policySetID, projectID, err)
+		return diag.Errorf(
+			"error attaching policy set id %s to project %s: %s", policySetID, projectID, err)
 	}
 
 	d.SetId(fmt.Sprintf("%s_%s", projectID, policySetID))
 
-	return resourceTFEProjectPolicySetRead(d, meta)
+	return resourceTFEProjectPolicySetRead(ctx, d, meta)
 }
 
-func resourceTFEProjectPolicySetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEProjectPolicySetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	policySetID := d.Get("policy_set_id").(string)
@@ -93,7 +94,7 @@ func resourceTFEProjectPolicySetRead(d *schema.ResourceData, meta interface{}) e
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error reading configuration of policy set %s: %w", policySetID, err)
+		return diag.Errorf("error reading configuration of policy set %s: %s", policySetID, err)
 	}
 
 	isProjectAttached := false
@@ -115,7 +116,7 @@ func resourceTFEProjectPolicySetRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceTFEProjectPolicySetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEProjectPolicySetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	policySetID := d.Get("policy_set_id").(string)
@@ -127,8 +128,8 @@ func resourceTFEProjectPolicySetDelete(d *schema.ResourceData, meta interface{})
 
 	err := config.Client.PolicySets.RemoveProjects(ctx, policySetID, policySetRemoveProjectsOptions)
 	if err != nil {
-		return fmt.Errorf(
-			"error detaching project %s from policy set %s: %w", projectID, policySetID, err)
+		return diag.Errorf(
+			"error detaching project %s from policy set %s: %s", projectID, policySetID, err)
 	}
 
 	return nil
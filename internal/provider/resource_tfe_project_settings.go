@@ -287,7 +287,7 @@ func (r *projectSettings) Schema(ctx context.Context, req resource.SchemaRequest
 }
 
 // projectSettingsModelFromTFEProject builds a resource model from the TFE model
-func (r *projectSettings) projectSettingsModelFromTFEProject(proj models.Projectsable) *modelProjectSettings {
+func (r *projectSettings) projectSettingsModelFromTFEProject(ctx context.Context, proj models.Projectsable) *modelProjectSettings {
 	result := modelProjectSettings{
 		ID:        types.StringValue(valueOrZero(proj.GetId())),
 		ProjectID: types.StringValue(valueOrZero(proj.GetId())),
@@ -356,7 +356,7 @@ func (r *projectSettings) readSettings(ctx context.Context, projectID string) (*
 		return nil, errProjectNoLongerExists
 	}
 
-	return r.projectSettingsModelFromTFEProject(projEnvelope.GetData()), nil
+	return r.projectSettingsModelFromTFEProject(ctx, projEnvelope.GetData()), nil
 }
 
 // updateSettings intentionally stays on the go-tfe v1 client for this one Update call.
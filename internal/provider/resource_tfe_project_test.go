@@ -339,6 +339,7 @@ func TestResourceTFEProjectRead_RemovedProjectBackfillsIdentity(t *testing.T) {
 		Organization:                types.StringValue("example-org"),
 		AutoDestroyActivityDuration: types.StringNull(),
 		Tags:                        types.MapNull(types.StringType),
+		TagsAll:                     types.MapNull(types.StringType),
 		IgnoreAdditionalTags:        types.BoolValue(false),
 	})
 
@@ -365,6 +366,7 @@ func TestResourceTFEProjectRead_RemovedProjectPreservesExistingIdentity(t *testi
 		Organization:                types.StringValue("example-org"),
 		AutoDestroyActivityDuration: types.StringNull(),
 		Tags:                        types.MapNull(types.StringType),
+		TagsAll:                     types.MapNull(types.StringType),
 		IgnoreAdditionalTags:        types.BoolValue(false),
 	}, existingIdentity)
 
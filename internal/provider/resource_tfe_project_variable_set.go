@@ -16,6 +16,7 @@ import (
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -24,9 +25,9 @@ func resourceTFEProjectVariableSet() *schema.Resource {
 		Description: "Adds and removes a variable set to a project." +
 			"\n\n-> **Note:** This resource controls whether a project has access to a variable set, not whether a project owns the variable set. Ownership is specified by setting the `parent_project_id` on the `tfe_variable_set` resource.",
 
-		Create: resourceTFEProjectVariableSetCreate,
-		Read:   resourceTFEProjectVariableSetRead,
-		Delete: resourceTFEProjectVariableSetDelete,
+		CreateWithoutTimeout: resourceTFEProjectVariableSetCreate,
+		ReadWithoutTimeout:   resourceTFEProjectVariableSetRead,
+		DeleteWithoutTimeout: resourceTFEProjectVariableSetDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFEProjectVariableSetImporter,
 		},
@@ -55,7 +56,7 @@ func resourceTFEProjectVariableSet() *schema.Resource {
 	}
 }
 
-func resourceTFEProjectVariableSetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEProjectVariableSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	vSID := d.Get("variable_set_id").(string)
@@ -66,17 +67,17 @@ func resourceTFEProjectVariableSetCreate(d *schema.ResourceData, meta interface{
 
 	err := config.Client.VariableSets.ApplyToProjects(ctx, vSID, applyOptions)
 	if err != nil {
-		return fmt.Errorf(
-			"Error applying variable set id %s to project %s: %w", vSID, prjID, err)
+		return diag.Errorf(
+			"Error applying variable set id %s to project %s: %s", vSID, prjID, err)
 	}
 
 	id := encodeVariableSetProjectAttachment(prjID, vSID)
 	d.SetId(id)
 
-	return resourceTFEProjectVariableSetRead(d, meta)
+	return resourceTFEProjectVariableSetRead(ctx, d, meta)
 }
 
-func resourceTFEProjectVariableSetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEProjectVariableSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	prjID := d.Get("project_id").(string)
@@ -92,7 +93,7 @@ func resourceTFEProjectVariableSetRead(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading configuration of variable set %s: %w", d.Id(), err)
+		return diag.Errorf("Error reading configuration of variable set %s: %s", d.Id(), err)
 	}
 
 	// Verify project listed in variable set
@@ -114,7 +115,7 @@ func resourceTFEProjectVariableSetRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTFEProjectVariableSetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEProjectVariableSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	prjID := d.Get("project_id").(string)
@@ -126,8 +127,8 @@ func resourceTFEProjectVariableSetDelete(d *schema.ResourceData, meta interface{
 
 	err := config.Client.VariableSets.RemoveFromProjects(ctx, vSID, removeOptions)
 	if err != nil {
-		return fmt.Errorf(
-			"Error removing project %s from variable set %s: %w", prjID, vSID, err)
+		return diag.Errorf(
+			"Error removing project %s from variable set %s: %s", prjID, vSID, err)
 	}
 
 	return nil
@@ -18,6 +18,7 @@ import (
 
 	tfe "github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/go-tfe/v2/api/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -31,14 +32,19 @@ func resourceTFERegistryModule() *schema.Resource {
 			"\n\n~> **Note:**  To manage this resource, the token used with the provider needs to be for a team with **owner** permissions or a user who has the permissions explicitly assigned. Crucially, this **does not work** with an organization token! See the [API Access Levels](https://developer.hashicorp.com/terraform/cloud-docs/users-teams-organizations/api-tokens#access-levels) documentation for more information." +
 			"\n\n~> **Note:** When using `source_directory`, you **must** explicitly specify both `name` and `module_provider`. This is required because monorepos and repositories with non-standard names (not following `terraform-<provider>-<name>` convention) cannot have these values automatically inferred by the API.",
 
-		Create: resourceTFERegistryModuleCreate,
-		Read:   resourceTFERegistryModuleRead,
-		Update: resourceTFERegistryModuleUpdate,
-		Delete: resourceTFERegistryModuleDelete,
+		CreateWithoutTimeout: resourceTFERegistryModuleCreate,
+		ReadWithoutTimeout:   resourceTFERegistryModuleRead,
+		UpdateWithoutTimeout: resourceTFERegistryModuleUpdate,
+		DeleteWithoutTimeout: resourceTFERegistryModuleDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFERegistryModuleImporter,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		CustomizeDiff: func(c context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			if err := validateNameAndProvider(d); err != nil {
 				return err
@@ -241,7 +247,7 @@ func resourceTFERegistryModule() *schema.Resource {
 	}
 }
 
-func resourceTFERegistryModuleCreateWithVCS(v interface{}, meta interface{}, d *schema.ResourceData) (*tfe.RegistryModule, error) {
+func resourceTFERegistryModuleCreateWithVCS(ctx context.Context, v interface{}, meta interface{}, d *schema.ResourceData) (*tfe.RegistryModule, error) {
 	config := meta.(ConfiguredClient)
 	// Create module with VCS repo configuration block.
 	options := tfe.RegistryModuleCreateWithVCSConnectionOptions{}
@@ -337,7 +343,7 @@ func resourceTFERegistryModuleCreateWithVCS(v interface{}, meta interface{}, d *
 	return registryModule, nil
 }
 
-func resourceTFERegistryModuleCreateWithoutVCS(meta interface{}, d *schema.ResourceData) (*tfe.RegistryModule, error) {
+func resourceTFERegistryModuleCreateWithoutVCS(ctx context.Context, meta interface{}, d *schema.ResourceData) (*tfe.RegistryModule, error) {
 	config := meta.(ConfiguredClient)
 
 	options := tfe.RegistryModuleCreateOptions{
@@ -370,42 +376,53 @@ func resourceTFERegistryModuleCreateWithoutVCS(meta interface{}, d *schema.Resou
 	return registryModule, nil
 }
 
-func resourceTFERegistryModuleCreate(d *schema.ResourceData, meta interface{}) error {
+// waitForRegistryModuleIngestion waits until the registry module can be read,
+// which it cannot until it has been ingested.
+func waitForRegistryModuleIngestion(ctx context.Context, client *tfe.Client, rmID tfe.RegistryModuleID, timeout time.Duration) error {
+	err := retryUntilTimeout(ctx, timeout, func() *retry.RetryError {
+		_, err := client.RegistryModules.Read(ctx, rmID)
+		if err != nil {
+			if strings.Contains(strings.ToLower(err.Error()), "not found") {
+				return retry.RetryableError(err)
+			}
+			return retry.NonRetryableError(err)
+		}
+		return nil
+	})
+
+	if isTimeoutError(err) {
+		return fmt.Errorf("timed out after %s waiting for module %s/%s to be ingested: %w", timeout, rmID.Organization, rmID.Name, err)
+	}
+	if err != nil {
+		return fmt.Errorf("Error while waiting for module %s/%s to be ingested: %w", rmID.Organization, rmID.Name, err)
+	}
+	return nil
+}
+
+func resourceTFERegistryModuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 	var registryModule *tfe.RegistryModule
 	var err error
 
 	if v, ok := d.GetOk("vcs_repo"); ok {
-		registryModule, err = resourceTFERegistryModuleCreateWithVCS(v, meta, d)
+		registryModule, err = resourceTFERegistryModuleCreateWithVCS(ctx, v, meta, d)
 	} else {
-		registryModule, err = resourceTFERegistryModuleCreateWithoutVCS(meta, d)
+		registryModule, err = resourceTFERegistryModuleCreateWithoutVCS(ctx, meta, d)
 	}
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	var rmID tfe.RegistryModuleID
-	err = retry.Retry(time.Duration(5)*time.Minute, func() *retry.RetryError {
-		rmID = tfe.RegistryModuleID{
-			Organization: registryModule.Organization.Name,
-			Name:         registryModule.Name,
-			Provider:     registryModule.Provider,
-			Namespace:    registryModule.Namespace,
-			RegistryName: registryModule.RegistryName,
-		}
-		_, err := config.Client.RegistryModules.Read(ctx, rmID)
-		if err != nil {
-			if strings.Contains(strings.ToLower(err.Error()), "not found") {
-				return retry.RetryableError(err)
-			}
-			return retry.NonRetryableError(err)
-		}
-		return nil
-	})
-
-	if err != nil {
-		return fmt.Errorf("Error while waiting for module %s/%s to be ingested: %w", registryModule.Organization.Name, registryModule.Name, err)
+	rmID := tfe.RegistryModuleID{
+		Organization: registryModule.Organization.Name,
+		Name:         registryModule.Name,
+		Provider:     registryModule.Provider,
+		Namespace:    registryModule.Namespace,
+		RegistryName: registryModule.RegistryName,
+	}
+	if err := waitForRegistryModuleIngestion(ctx, config.Client, rmID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.SetId(registryModule.ID)
@@ -419,13 +436,13 @@ func resourceTFERegistryModuleCreate(d *schema.ResourceData, meta interface{}) e
 
 	err = helpers.WriteRegistryIdentity(d, registryModule.ID, rmID, config.Client.BaseURL().Host)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTFERegistryModuleRead(d, meta)
+	return resourceTFERegistryModuleRead(ctx, d, meta)
 }
 
-func resourceTFERegistryModuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFERegistryModuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	options := tfe.RegistryModuleUpdateOptions{}
@@ -447,14 +464,14 @@ func resourceTFERegistryModuleUpdate(d *schema.ResourceData, meta interface{}) e
 
 	if v, ok := d.GetOk("vcs_repo"); ok {
 		vcsRepo := v.([]interface{})[0].(map[string]interface{})
-		if err := updateRegistryModuleVCSRepo(config, rmID, vcsRepo); err != nil {
-			return err
+		if err := updateRegistryModuleVCSRepo(ctx, config, rmID, vcsRepo, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
 	if v, ok := d.GetOk("test_config"); ok {
 		if v.([]interface{})[0] == nil {
-			return fmt.Errorf("tests_enabled must be provided when configuring a test_config")
+			return diag.Errorf("tests_enabled must be provided when configuring a test_config")
 		}
 
 		testConfig := v.([]interface{})[0].(map[string]interface{})
@@ -474,7 +491,7 @@ func resourceTFERegistryModuleUpdate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	if options.NoCode != nil || options.TestConfig != nil {
-		err = retry.Retry(time.Duration(5)*time.Minute, func() *retry.RetryError {
+		err = retryUntilTimeout(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
 			registryModule, err = config.Client.RegistryModules.Update(ctx, rmID, options)
 			if err != nil {
 				return retry.RetryableError(err)
@@ -482,8 +499,11 @@ func resourceTFERegistryModuleUpdate(d *schema.ResourceData, meta interface{}) e
 			return nil
 		})
 
+		if isTimeoutError(err) {
+			return diag.Errorf("timed out after %s waiting for module %s/%s to be updated: %s", d.Timeout(schema.TimeoutUpdate), rmID.Organization, rmID.Name, err)
+		}
 		if err != nil {
-			return fmt.Errorf("Error while waiting for module %s/%s to be updated: %w", rmID.Organization, rmID.Name, err)
+			return diag.Errorf("Error while waiting for module %s/%s to be updated: %s", rmID.Organization, rmID.Name, err)
 		}
 
 		if registryModule != nil {
@@ -491,10 +511,10 @@ func resourceTFERegistryModuleUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	return resourceTFERegistryModuleRead(d, meta)
+	return resourceTFERegistryModuleRead(ctx, d, meta)
 }
 
-func updateRegistryModuleVCSRepo(config ConfiguredClient, rmID tfe.RegistryModuleID, vcsRepo map[string]interface{}) error {
+func updateRegistryModuleVCSRepo(ctx context.Context, config ConfiguredClient, rmID tfe.RegistryModuleID, vcsRepo map[string]interface{}, timeout time.Duration) error {
 	vcsRepoModel := models.NewRegistryModules_attributes_vcsRepo()
 
 	if branch, ok := vcsRepo["branch"].(string); ok && branch != "" {
@@ -527,7 +547,7 @@ func updateRegistryModuleVCSRepo(config ConfiguredClient, rmID tfe.RegistryModul
 	envelope := models.NewRegistryModulesEnvelope()
 	envelope.SetData(data)
 
-	err := retry.Retry(time.Duration(5)*time.Minute, func() *retry.RetryError {
+	err := retryUntilTimeout(ctx, timeout, func() *retry.RetryError {
 		_, updateErr := config.ClientV2.API.
 			Organizations().ByOrganization_name(rmID.Organization).
 			RegistryModules().
@@ -541,6 +561,9 @@ func updateRegistryModuleVCSRepo(config ConfiguredClient, rmID tfe.RegistryModul
 		}
 		return nil
 	})
+	if isTimeoutError(err) {
+		return fmt.Errorf("timed out after %s updating vcs_repo for module %s/%s: %w", timeout, rmID.Organization, rmID.Name, err)
+	}
 	if err != nil {
 		return fmt.Errorf("Error while updating vcs_repo for module %s/%s: %w", rmID.Organization, rmID.Name, err)
 	}
@@ -548,7 +571,7 @@ func updateRegistryModuleVCSRepo(config ConfiguredClient, rmID tfe.RegistryModul
 	return nil
 }
 
-func resourceTFERegistryModuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFERegistryModuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read registry module: %s", d.Id())
@@ -569,12 +592,12 @@ func resourceTFERegistryModuleRead(d *schema.ResourceData, meta interface{}) err
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading registry module %s: %w", d.Id(), err)
+		return diag.Errorf("Error reading registry module %s: %s", d.Id(), err)
 	}
 
 	err = helpers.WriteRegistryIdentity(d, registryModule.ID, rmID, config.Client.BaseURL().Host)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Update the config
@@ -628,7 +651,7 @@ func resourceTFERegistryModuleRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceTFERegistryModuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFERegistryModuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Fields required to delete registry module by provider
@@ -646,14 +669,14 @@ func resourceTFERegistryModuleDelete(d *schema.ResourceData, meta interface{}) e
 
 		err := config.Client.RegistryModules.DeleteProvider(ctx, rModID)
 		if err != nil && !errors.Is(err, tfe.ErrResourceNotFound) {
-			return fmt.Errorf("error deleting registry module provider: %w", err)
+			return diag.Errorf("error deleting registry module provider: %s", err)
 		}
 	} else {
 		log.Printf("[DEBUG] Delete registry module by name: %s", d.Id())
 
 		err := config.Client.RegistryModules.DeleteByName(ctx, rModID)
 		if err != nil && !errors.Is(err, tfe.ErrResourceNotFound) {
-			return fmt.Errorf("Error deleting registry module %s: %w", d.Id(), err)
+			return diag.Errorf("Error deleting registry module %s: %s", d.Id(), err)
 		}
 	}
 
@@ -4,6 +4,7 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"os"
@@ -14,12 +15,28 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-tfe"
+	tfemocks "github.com/hashicorp/go-tfe/mocks"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"go.uber.org/mock/gomock"
 )
 
+func TestWaitForRegistryModuleIngestion_timesOut(t *testing.T) {
+	client := testTfeClient(t, testClientOptions{})
+	ctrl := gomock.NewController(t)
+	mockRegistryModulesAPI := tfemocks.NewMockRegistryModules(ctrl)
+	mockRegistryModulesAPI.EXPECT().Read(gomock.Any(), gomock.Any()).Return(nil, tfe.ErrResourceNotFound).AnyTimes()
+	client.RegistryModules = mockRegistryModulesAPI
+
+	rmID := tfe.RegistryModuleID{Organization: "hashicorp", Name: "vpc", Provider: "aws"}
+	err := waitForRegistryModuleIngestion(context.Background(), client, rmID, 10*time.Millisecond)
+	if err == nil || !strings.HasPrefix(err.Error(), "timed out after 10ms waiting for module hashicorp/vpc to be ingested") {
+		t.Fatalf("expected a timeout error, got %v", err)
+	}
+}
+
 func TestAccTFERegistryModule_vcsBasic(t *testing.T) {
 	registryModule := &tfe.RegistryModule{}
 	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
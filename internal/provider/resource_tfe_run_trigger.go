@@ -9,14 +9,15 @@
 package provider
 
 import (
+	"context"
 	"errors"
-	"fmt"
 	"log"
 	"strings"
 	"time"
 
 	tfe "github.com/hashicorp/go-tfe/v2"
 	"github.com/hashicorp/go-tfe/v2/api/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -26,9 +27,9 @@ func resourceTFERunTrigger() *schema.Resource {
 		Description: "Manages run triggers." +
 			"\n\nHCP Terraform provides a way to connect your workspace to one or more workspaces within your organization, known as \"source workspaces\". These connections, called run triggers, allow runs to queue automatically in your workspace on successful apply of runs in any of the source workspaces. You can connect your workspace to up to 20 source workspaces.",
 
-		Create: resourceTFERunTriggerCreate,
-		Read:   resourceTFERunTriggerRead,
-		Delete: resourceTFERunTriggerDelete,
+		CreateWithoutTimeout: resourceTFERunTriggerCreate,
+		ReadWithoutTimeout:   resourceTFERunTriggerRead,
+		DeleteWithoutTimeout: resourceTFERunTriggerDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -95,7 +96,7 @@ func isRunTriggerCreationLocked(err error) bool {
 	return false
 }
 
-func resourceTFERunTriggerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFERunTriggerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get attributes
@@ -124,13 +125,13 @@ func resourceTFERunTriggerCreate(d *schema.ResourceData, meta interface{}) error
 	})
 
 	if err != nil {
-		return fmt.Errorf("Error creating run trigger on workspace %s with sourceable %s: %w", workspaceID, sourceableID, err)
+		return diag.Errorf("Error creating run trigger on workspace %s with sourceable %s: %s", workspaceID, sourceableID, err)
 	}
 
-	return resourceTFERunTriggerRead(d, meta)
+	return resourceTFERunTriggerRead(ctx, d, meta)
 }
 
-func resourceTFERunTriggerRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFERunTriggerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read run trigger: %s", d.Id())
@@ -141,7 +142,7 @@ func resourceTFERunTriggerRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading run trigger %s: %w", d.Id(), err)
+		return diag.Errorf("Error reading run trigger %s: %s", d.Id(), err)
 	}
 	if runTriggerEnvelope == nil || runTriggerEnvelope.GetData() == nil {
 		log.Printf("[DEBUG] run trigger %s no longer exists", d.Id())
@@ -170,7 +171,7 @@ func runTriggerSourceableID(relationships models.RunTriggers_relationshipsable)
 	return valueOrZero(relationships.GetSourceable().GetData().GetId())
 }
 
-func resourceTFERunTriggerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFERunTriggerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Delete run trigger: %s", d.Id())
@@ -179,7 +180,7 @@ func resourceTFERunTriggerDelete(d *schema.ResourceData, meta interface{}) error
 		if errors.Is(err, tfe.ErrNotFound) {
 			return nil
 		}
-		return fmt.Errorf("Error deleting run trigger %s: %w", d.Id(), err)
+		return diag.Errorf("Error deleting run trigger %s: %s", d.Id(), err)
 	}
 
 	return nil
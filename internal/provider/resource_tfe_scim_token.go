@@ -77,7 +77,8 @@ func (m replaceWhenExpiredAtRemoved) PlanModifyString(ctx context.Context, req p
 
 // resourceTFESCIMToken implements the tfe_scim_token resource type
 type resourceTFESCIMToken struct {
-	client *tfe.Client
+	client   *tfe.Client
+	readOnly bool
 }
 
 // modelFromTFEAdminSCIMToken builds a modelTFESCIMToken struct from a tfe.AdminSCIMToken value
@@ -97,6 +98,7 @@ var (
 	_ resource.Resource                = &resourceTFESCIMToken{}
 	_ resource.ResourceWithConfigure   = &resourceTFESCIMToken{}
 	_ resource.ResourceWithImportState = &resourceTFESCIMToken{}
+	_ resource.ResourceWithModifyPlan  = &resourceTFESCIMToken{}
 )
 
 // NewSCIMTokenResource is a resource function for the framework provider.
@@ -125,6 +127,12 @@ func (r *resourceTFESCIMToken) Configure(_ context.Context, req resource.Configu
 		return
 	}
 	r.client = client.Client
+	r.readOnly = client.ReadOnly
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan
+func (r *resourceTFESCIMToken) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanForReadOnly(r.readOnly, req, resp)
 }
 
 func (r *resourceTFESCIMToken) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
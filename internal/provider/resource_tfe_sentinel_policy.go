@@ -15,6 +15,7 @@ import (
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -24,11 +25,11 @@ func resourceTFESentinelPolicy() *schema.Resource {
 		Description: "Manages Sentinel policies." +
 			"\n\nSentinel Policy as Code is an embedded policy as code framework integrated with Terraform Enterprise. Policies are configured on a per-organization level and are organized and grouped into policy sets, which define the workspaces on which policies are enforced during runs.",
 
-		DeprecationMessage: "The `tfe_sentinel_policy` resource is deprecated. Use `tfe_policy` instead.",
-		Create:             resourceTFESentinelPolicyCreate,
-		Read:               resourceTFESentinelPolicyRead,
-		Update:             resourceTFESentinelPolicyUpdate,
-		Delete:             resourceTFESentinelPolicyDelete,
+		DeprecationMessage:   "The `tfe_sentinel_policy` resource is deprecated. Use `tfe_policy` instead.",
+		CreateWithoutTimeout: resourceTFESentinelPolicyCreate,
+		ReadWithoutTimeout:   resourceTFESentinelPolicyRead,
+		UpdateWithoutTimeout: resourceTFESentinelPolicyUpdate,
+		DeleteWithoutTimeout: resourceTFESentinelPolicyDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFESentinelPolicyImporter,
 		},
@@ -88,14 +89,14 @@ func resourceTFESentinelPolicy() *schema.Resource {
 	}
 }
 
-func resourceTFESentinelPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFESentinelPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the name and organization.
 	name := d.Get("name").(string)
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Create a new options struct.
@@ -116,8 +117,8 @@ func resourceTFESentinelPolicyCreate(d *schema.ResourceData, meta interface{}) e
 	log.Printf("[DEBUG] Create sentinel policy %s for organization: %s", name, organization)
 	policy, err := config.Client.Policies.Create(ctx, organization, options)
 	if err != nil {
-		return fmt.Errorf(
-			"Error creating sentinel policy %s for organization %s: %w", name, organization, err)
+		return diag.Errorf(
+			"Error creating sentinel policy %s for organization %s: %s", name, organization, err)
 	}
 
 	d.SetId(policy.ID)
@@ -125,14 +126,14 @@ func resourceTFESentinelPolicyCreate(d *schema.ResourceData, meta interface{}) e
 	log.Printf("[DEBUG] Upload sentinel policy %s for organization: %s", name, organization)
 	err = config.Client.Policies.Upload(ctx, policy.ID, []byte(d.Get("policy").(string)))
 	if err != nil {
-		return fmt.Errorf(
-			"Error uploading sentinel policy %s for organization %s: %w", name, organization, err)
+		return diag.Errorf(
+			"Error uploading sentinel policy %s for organization %s: %s", name, organization, err)
 	}
 
-	return resourceTFESentinelPolicyRead(d, meta)
+	return resourceTFESentinelPolicyRead(ctx, d, meta)
 }
 
-func resourceTFESentinelPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFESentinelPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read sentinel policy: %s", d.Id())
@@ -143,7 +144,7 @@ func resourceTFESentinelPolicyRead(d *schema.ResourceData, meta interface{}) err
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading sentinel policy %s: %w", d.Id(), err)
+		return diag.Errorf("Error reading sentinel policy %s: %s", d.Id(), err)
 	}
 
 	// Update the config.
@@ -157,14 +158,14 @@ func resourceTFESentinelPolicyRead(d *schema.ResourceData, meta interface{}) err
 
 	content, err := config.Client.Policies.Download(ctx, policy.ID)
 	if err != nil {
-		return fmt.Errorf("Error downloading sentinel policy %s: %w", d.Id(), err)
+		return diag.Errorf("Error downloading sentinel policy %s: %s", d.Id(), err)
 	}
 	d.Set("policy", string(content))
 
 	return nil
 }
 
-func resourceTFESentinelPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFESentinelPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	if d.HasChange("description") || d.HasChange("enforce_mode") {
@@ -188,8 +189,8 @@ func resourceTFESentinelPolicyUpdate(d *schema.ResourceData, meta interface{}) e
 		log.Printf("[DEBUG] Update configuration for sentinel policy: %s", d.Id())
 		_, err := config.Client.Policies.Update(ctx, d.Id(), options)
 		if err != nil {
-			return fmt.Errorf(
-				"Error updating configuration for sentinel policy %s: %w", d.Id(), err)
+			return diag.Errorf(
+				"Error updating configuration for sentinel policy %s: %s", d.Id(), err)
 		}
 	}
 
@@ -197,14 +198,14 @@ func resourceTFESentinelPolicyUpdate(d *schema.ResourceData, meta interface{}) e
 		log.Printf("[DEBUG] Update sentinel policy: %s", d.Id())
 		err := config.Client.Policies.Upload(ctx, d.Id(), []byte(d.Get("policy").(string)))
 		if err != nil {
-			return fmt.Errorf("Error updating sentinel policy %s: %w", d.Id(), err)
+			return diag.Errorf("Error updating sentinel policy %s: %s", d.Id(), err)
 		}
 	}
 
-	return resourceTFESentinelPolicyRead(d, meta)
+	return resourceTFESentinelPolicyRead(ctx, d, meta)
 }
 
-func resourceTFESentinelPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFESentinelPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Delete sentinel policy: %s", d.Id())
@@ -213,7 +214,7 @@ func resourceTFESentinelPolicyDelete(d *schema.ResourceData, meta interface{}) e
 		if err == tfe.ErrResourceNotFound {
 			return nil
 		}
-		return fmt.Errorf("Error deleting sentinel policy %s: %w", d.Id(), err)
+		return diag.Errorf("Error deleting sentinel policy %s: %s", d.Id(), err)
 	}
 
 	return nil
@@ -404,7 +404,7 @@ func (r *sentinelVersionResource) ImportState(ctx context.Context, req resource.
 	// determines if the string is a tool version ID
 	s := strings.Split(req.ID, "-")
 	if s[0] != "tool" {
-		versionID, err := fetchSentinelVersionID(req.ID, r.config.Client)
+		versionID, err := fetchSentinelVersionID(ctx, req.ID, r.config.Client)
 		tflog.Debug(ctx, "Importing sentinel version", map[string]interface{}{
 			"version_id": versionID,
 		})
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -25,6 +26,13 @@ var _ resource.Resource = &resourceTFEStack{}
 var _ resource.ResourceWithConfigure = &resourceTFEStack{}
 var _ resource.ResourceWithImportState = &resourceTFEStack{}
 var _ resource.ResourceWithValidateConfig = &resourceTFEStack{}
+var _ resource.ResourceWithModifyPlan = &resourceTFEStack{}
+
+// minTFEVersionStacks is the first version of Terraform Enterprise with stacks.
+const minTFEVersionStacks = "1.0.0"
+
+// defaultStackTimeout is the default timeout of every operation on a stack.
+const defaultStackTimeout = 20 * time.Minute
 
 func NewStackResource() resource.Resource {
 	return &resourceTFEStack{}
@@ -47,6 +55,7 @@ func (r *resourceTFEStack) Schema(ctx context.Context, req resource.SchemaReques
 		Version: 1,
 
 		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(defaultStackTimeout, defaultStackTimeout, defaultStackTimeout),
 			"vcs_repo": schema.SingleNestedBlock{
 				Description: "VCS repository configuration for the Stack.",
 				Attributes: map[string]schema.Attribute{
@@ -153,6 +162,13 @@ func (r *resourceTFEStack) IdentitySchema(ctx context.Context, req resource.Iden
 	}
 }
 
+func (r *resourceTFEStack) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanForFeatureRequirements(ctx, r.config, featureRequirements{
+		feature:       "tfe_stack",
+		minTFEVersion: minTFEVersionStacks,
+	}, req, resp)
+}
+
 // Configure implements resource.ResourceWithConfigure
 func (r *resourceTFEStack) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
@@ -203,9 +219,9 @@ func (r *resourceTFEStack) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	if resp.Diagnostics.HasError() {
-		return
-	}
+	timeout := plan.Timeouts.create(defaultStackTimeout)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
 	options := tfe.StackCreateOptions{
 		Name: plan.Name.ValueString(),
@@ -253,6 +269,10 @@ func (r *resourceTFEStack) Create(ctx context.Context, req resource.CreateReques
 	stack, err := r.config.Client.Stacks.Create(ctx, options)
 	if err != nil {
 		tflog.Error(ctx, "Error creating stack", map[string]interface{}{"error": err.Error()})
+		if isTimeoutError(err) {
+			resp.Diagnostics.AddError("Unable to create stack", fmt.Sprintf("Timed out after %s waiting for stack %s to be created: %s", timeout, options.Name, err))
+			return
+		}
 		resp.Diagnostics.AddError("Unable to create stack", err.Error())
 		return
 	}
@@ -260,6 +280,7 @@ func (r *resourceTFEStack) Create(ctx context.Context, req resource.CreateReques
 	result := modelFromTFEStack(stack)
 	// Preserve the migration value from plan since it's not returned by the API
 	result.Migration = plan.Migration
+	result.Timeouts = plan.Timeouts
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
@@ -296,6 +317,7 @@ func (r *resourceTFEStack) Read(ctx context.Context, req resource.ReadRequest, r
 	result := modelFromTFEStack(stack)
 	// Preserve the migration value from state since it's not returned by the API
 	result.Migration = state.Migration
+	result.Timeouts = state.Timeouts
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
@@ -323,6 +345,10 @@ func (r *resourceTFEStack) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	timeout := plan.Timeouts.update(defaultStackTimeout)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	options := tfe.StackUpdateOptions{
 		Name:               tfe.String(plan.Name.ValueString()),
 		Description:        tfe.String(plan.Description.ValueString()),
@@ -363,6 +389,10 @@ func (r *resourceTFEStack) Update(ctx context.Context, req resource.UpdateReques
 	tflog.Debug(ctx, "Updating stack")
 	stack, err := r.config.Client.Stacks.Update(ctx, state.ID.ValueString(), options)
 	if err != nil {
+		if isTimeoutError(err) {
+			resp.Diagnostics.AddError("Unable to update stack", fmt.Sprintf("Timed out after %s waiting for stack %s to be updated: %s", timeout, state.ID.ValueString(), err))
+			return
+		}
 		resp.Diagnostics.AddError("Unable to update stack", err.Error())
 		return
 	}
@@ -370,6 +400,7 @@ func (r *resourceTFEStack) Update(ctx context.Context, req resource.UpdateReques
 	result := modelFromTFEStack(stack)
 	// Preserve the migration value from state since it's not returned by the API
 	result.Migration = state.Migration
+	result.Timeouts = plan.Timeouts
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
@@ -396,9 +427,17 @@ func (r *resourceTFEStack) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	timeout := state.Timeouts.delete(defaultStackTimeout)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	tflog.Debug(ctx, "Deleting stack")
 	err := r.config.Client.Stacks.Delete(ctx, state.ID.ValueString())
 	if err != nil {
+		if isTimeoutError(err) {
+			resp.Diagnostics.AddError("Unable to delete stack", fmt.Sprintf("Timed out after %s waiting for stack %s to be deleted: %s", timeout, state.ID.ValueString(), err))
+			return
+		}
 		resp.Diagnostics.AddError("Unable to delete stack", err.Error())
 		return
 	}
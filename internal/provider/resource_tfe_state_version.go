@@ -0,0 +1,309 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type resourceTFEStateVersion struct {
+	config ConfiguredClient
+}
+
+var _ resource.Resource = &resourceTFEStateVersion{}
+var _ resource.ResourceWithConfigure = &resourceTFEStateVersion{}
+var _ resource.ResourceWithModifyPlan = &resourceTFEStateVersion{}
+
+func NewStateVersionResource() resource.Resource {
+	return &resourceTFEStateVersion{}
+}
+
+type modelTFEStateVersion struct {
+	ID          types.String  `tfsdk:"id"`
+	WorkspaceID types.String  `tfsdk:"workspace_id"`
+	StateFile   types.String  `tfsdk:"state_file"`
+	StateJSON   types.String  `tfsdk:"state_json"`
+	Force       types.Bool    `tfsdk:"force"`
+	MD5         types.String  `tfsdk:"md5"`
+	Serial      types.Int64   `tfsdk:"serial"`
+	Lineage     types.String  `tfsdk:"lineage"`
+	Outputs     types.Dynamic `tfsdk:"outputs"`
+}
+
+// stateContent returns the raw state to upload, read from state_file or taken
+// from state_json, and whether it is known yet.
+func (m *modelTFEStateVersion) stateContent() ([]byte, bool, error) {
+	if !m.StateFile.IsNull() {
+		if m.StateFile.IsUnknown() {
+			return nil, false, nil
+		}
+		raw, err := os.ReadFile(m.StateFile.ValueString())
+		if err != nil {
+			return nil, false, fmt.Errorf("error reading state file: %w", err)
+		}
+		return raw, true, nil
+	}
+	if m.StateJSON.IsUnknown() {
+		return nil, false, nil
+	}
+	return []byte(m.StateJSON.ValueString()), true, nil
+}
+
+// setState sets the attributes derived from the raw state.
+func (m *modelTFEStateVersion) setState(raw []byte, state *stateFile) diag.Diagnostics {
+	outputs, diags := state.outputsValue()
+	if diags.HasError() {
+		return diags
+	}
+
+	m.MD5 = types.StringValue(stateMD5(raw))
+	m.Serial = types.Int64Value(*state.Serial)
+	m.Lineage = types.StringValue(state.Lineage)
+	m.Outputs = outputs
+	return diags
+}
+
+func (r *resourceTFEStateVersion) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_state_version"
+}
+
+func (r *resourceTFEStateVersion) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ConfiguredClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected resource Configure type",
+			fmt.Sprintf("Expected tfe.ConfiguredClient, got %T. This is a bug in the tfe provider, so please report it on GitHub.", req.ProviderData),
+		)
+	}
+	r.config = client
+}
+
+func (r *resourceTFEStateVersion) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Uploads a Terraform state file as a new state version of a workspace. The serial and the lineage are taken from the state, and the workspace is locked during the upload." +
+			"\n\n~> **Note:** State versions cannot be deleted. Destroying this resource only removes it from the Terraform state, the state version remains in the workspace.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the state version.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workspace_id": schema.StringAttribute{
+				Description: "The ID of the workspace to upload the state to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"state_file": schema.StringAttribute{
+				Description: "The path to the state file to upload. Exactly one of `state_file` or `state_json` must be set.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("state_json")),
+				},
+			},
+			"state_json": schema.StringAttribute{
+				Description: "The raw JSON of the state to upload. Exactly one of `state_file` or `state_json` must be set.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"force": schema.BoolAttribute{
+				Description: "Whether to upload the state even when the current state version of the workspace has a newer serial or a different lineage. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"md5": schema.StringAttribute{
+				Description: "The MD5 hash of the uploaded state. A change of the state replaces the state version.",
+				Computed:    true,
+			},
+			"serial": schema.Int64Attribute{
+				Description: "The serial of the uploaded state.",
+				Computed:    true,
+			},
+			"lineage": schema.StringAttribute{
+				Description: "The lineage of the uploaded state.",
+				Computed:    true,
+			},
+			"outputs": schema.DynamicAttribute{
+				Description: "The values of the outputs of the uploaded state, keyed by output name.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// ModifyPlan parses the state to upload at plan time, so that invalid states
+// are reported before the apply and a changed state replaces the state version.
+func (r *resourceTFEStateVersion) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		// Destroy plan
+		return
+	}
+
+	var plan modelTFEStateVersion
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var priorMD5 types.String
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("md5"), &priorMD5)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	raw, known, err := plan.stateContent()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("state_file"), "Error reading state", err.Error())
+		return
+	}
+	if !known {
+		if !priorMD5.IsNull() {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("md5"))
+		}
+		return
+	}
+
+	state, err := parseStateFile(raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid state", fmt.Sprintf("The state cannot be uploaded: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(plan.setState(raw, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !priorMD5.IsNull() && priorMD5.ValueString() != plan.MD5.ValueString() {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("md5"))
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *resourceTFEStateVersion) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan modelTFEStateVersion
+
+	// Read Terraform planned changes into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	raw, _, err := plan.stateContent()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("state_file"), "Error reading state", err.Error())
+		return
+	}
+	if !plan.MD5.IsUnknown() && plan.MD5.ValueString() != stateMD5(raw) {
+		resp.Diagnostics.AddError("State changed", "The state to upload changed after the plan, run the plan again to upload it.")
+		return
+	}
+
+	state, err := parseStateFile(raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid state", fmt.Sprintf("The state cannot be uploaded: %s", err))
+		return
+	}
+
+	workspaceID := plan.WorkspaceID.ValueString()
+	tflog.Debug(ctx, "Uploading state version", map[string]any{"workspace_id": workspaceID, "serial": *state.Serial})
+	sv, err := uploadStateVersion(ctx, r.config.Client, workspaceID, raw, state, plan.Force.ValueBool())
+	if sv == nil {
+		resp.Diagnostics.AddError("Error uploading state version", err.Error())
+		return
+	}
+	if err != nil {
+		// The state version was uploaded, only unlocking the workspace failed.
+		resp.Diagnostics.AddWarning("Error unlocking workspace", err.Error())
+	}
+
+	plan.ID = types.StringValue(sv.ID)
+	resp.Diagnostics.Append(plan.setState(raw, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceTFEStateVersion) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state modelTFEStateVersion
+
+	// Read Terraform current state into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading state version", map[string]any{"id": state.ID.ValueString()})
+	sv, err := r.config.Client.StateVersions.Read(ctx, state.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, tfe.ErrResourceNotFound) {
+			tflog.Debug(ctx, "State version no longer exists", map[string]any{"id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading state version", fmt.Sprintf("Couldn't read state version %s: %s", state.ID.ValueString(), err))
+		return
+	}
+
+	state.Serial = types.Int64Value(sv.Serial)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceTFEStateVersion) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan modelTFEStateVersion
+
+	// Only force can be updated, which only matters for the upload, so the
+	// plan is saved as is.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceTFEStateVersion) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state modelTFEStateVersion
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// State versions cannot be deleted, the state version is only removed
+	// from the Terraform state.
+	tflog.Debug(ctx, "State versions cannot be deleted, removing it from state", map[string]any{"id": state.ID.ValueString()})
+}
@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"math/rand"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTFEStateVersion_basic(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+	resourceAddress := "tfe_state_version.foobar"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEStateVersion_file(rInt, "test-fixtures/state-versions/terraform.tfstate"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceAddress, "id", regexp.MustCompile(`^sv-`)),
+					resource.TestCheckResourceAttr(resourceAddress, "serial", "2"),
+					resource.TestCheckResourceAttr(resourceAddress, "lineage", "b2b54b23-e7ea-5500-7b15-fcb68c1d92bb"),
+					resource.TestCheckResourceAttr(resourceAddress, "force", "false"),
+					resource.TestCheckResourceAttrSet(resourceAddress, "md5"),
+					resource.TestCheckResourceAttr(resourceAddress, "outputs.test_output_string", "9023256633839603543"),
+					resource.TestCheckResourceAttr(resourceAddress, "outputs.test_output_number", "5"),
+				),
+			},
+			{
+				// A different state with the same serial replaces the state version.
+				Config: testAccTFEStateVersion_file(rInt, "test-fixtures/state-versions/terraform-empty-outputs.tfstate"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceAddress, "serial", "2"),
+					resource.TestCheckNoResourceAttr(resourceAddress, "outputs.test_output_string"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTFEStateVersion_olderSerial(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTFEStateVersion_json(rInt, 5, false),
+			},
+			{
+				Config:      testAccTFEStateVersion_json(rInt, 3, false),
+				ExpectError: regexp.MustCompile(`Set force to true to upload the state anyway`),
+			},
+			{
+				Config: testAccTFEStateVersion_json(rInt, 3, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("tfe_state_version.foobar", "serial", "3"),
+					resource.TestCheckResourceAttr("tfe_state_version.foobar", "force", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTFEStateVersion_invalidState(t *testing.T) {
+	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTFEStateVersion_file(rInt, "test-fixtures/state-versions/missing.tfstate"),
+				ExpectError: regexp.MustCompile(`Error reading state`),
+			},
+		},
+	})
+}
+
+func testAccTFEStateVersion_workspace(rInt int) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name  = "tst-terraform-%d"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name         = "workspace-test"
+  organization = tfe_organization.foobar.id
+}`, rInt)
+}
+
+func testAccTFEStateVersion_file(rInt int, fileName string) string {
+	return testAccTFEStateVersion_workspace(rInt) + fmt.Sprintf(`
+
+resource "tfe_state_version" "foobar" {
+  workspace_id = tfe_workspace.foobar.id
+  state_file   = "%s"
+}`, fileName)
+}
+
+func testAccTFEStateVersion_json(rInt int, serial int, force bool) string {
+	return testAccTFEStateVersion_workspace(rInt) + fmt.Sprintf(`
+
+resource "tfe_state_version" "foobar" {
+  workspace_id = tfe_workspace.foobar.id
+  state_json = jsonencode({
+    version           = 4
+    terraform_version = "1.14.0"
+    serial            = %d
+    lineage           = "0c7a5a9b-6a4b-4c2f-9b0e-0d1f8b7a6c5e"
+    outputs           = {}
+    resources         = []
+  })
+  force = %t
+}`, serial, force)
+}
@@ -13,10 +13,12 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe/v2"
 	"github.com/hashicorp/go-tfe/v2/api/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-tfe/internal/provider/helpers"
@@ -26,14 +28,16 @@ func resourceTFETeam() *schema.Resource {
 	return &schema.Resource{
 		Description: "Manages teams.",
 
-		Create: resourceTFETeamCreate,
-		Read:   resourceTFETeamRead,
-		Update: resourceTFETeamUpdate,
-		Delete: resourceTFETeamDelete,
+		CreateWithoutTimeout: resourceTFETeamCreate,
+		ReadWithoutTimeout:   resourceTFETeamRead,
+		UpdateWithoutTimeout: resourceTFETeamUpdate,
+		DeleteWithoutTimeout: resourceTFETeamDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFETeamImporter,
 		},
 
+		CustomizeDiff: customizeDiffTeamFeatureRequirements,
+
 		Identity: &schema.ResourceIdentity{
 			SchemaFunc: func() map[string]*schema.Schema {
 				return map[string]*schema.Schema{
@@ -234,14 +238,14 @@ func teamOrganizationAccessAttributesV2(d *schema.ResourceData) models.Teams_att
 	return access
 }
 
-func resourceTFETeamCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get team attributes.
 	name := d.Get("name").(string)
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	attributes := models.NewTeams_attributes()
@@ -251,7 +255,7 @@ func resourceTFETeamCreate(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := d.GetOk("visibility"); ok {
 		visibility, verr := models.ParseTeams_attributes_visibility(v.(string))
 		if verr != nil {
-			return fmt.Errorf("invalid team visibility %q: %w", v.(string), verr)
+			return diag.Errorf("invalid team visibility %q: %s", v.(string), verr)
 		}
 		attributes.SetVisibility(visibility.(*models.Teams_attributes_visibility))
 	}
@@ -273,18 +277,18 @@ func resourceTFETeamCreate(d *schema.ResourceData, meta interface{}) error {
 	result, err := config.ClientV2.API.Organizations().ByOrganization_name(organization).Teams().Post(ctx, envelope, nil)
 	if err != nil {
 		if errors.Is(err, tfe.ErrNotFound) {
-			entitlements, _ := config.Client.Organizations.ReadEntitlements(ctx, organization)
+			entitlements, _ := config.organizationEntitlements(ctx, organization)
 			if entitlements == nil {
-				return fmt.Errorf("Error creating team %s for organization %s: %w", name, organization, err)
+				return diag.Errorf("Error creating team %s for organization %s: %s", name, organization, err)
 			}
 			if !entitlements.Teams {
-				return fmt.Errorf("Error creating team %s for organization %s: missing entitlements to create teams", name, organization)
+				return diag.Errorf("Error creating team %s for organization %s: missing entitlements to create teams", name, organization)
 			}
 		}
-		return fmt.Errorf("Error creating team %s for organization %s: %w", name, organization, err)
+		return diag.Errorf("Error creating team %s for organization %s: %s", name, organization, err)
 	}
 	if result == nil || result.GetData() == nil {
-		return fmt.Errorf("Error creating team %s for organization %s: no data returned", name, organization)
+		return diag.Errorf("Error creating team %s for organization %s: no data returned", name, organization)
 	}
 
 	teamID := valueOrZero(result.GetData().GetId())
@@ -292,13 +296,13 @@ func resourceTFETeamCreate(d *schema.ResourceData, meta interface{}) error {
 
 	err = helpers.WriteTFEIdentityWithOrg(d, teamID, organization, config.Client.BaseURL().Host)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTFETeamRead(d, meta)
+	return resourceTFETeamRead(ctx, d, meta)
 }
 
-func resourceTFETeamRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read configuration of team: %s", d.Id())
@@ -309,7 +313,7 @@ func resourceTFETeamRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading configuration of team %s: %w", d.Id(), err)
+		return diag.Errorf("Error reading configuration of team %s: %s", d.Id(), err)
 	}
 	if result == nil || result.GetData() == nil {
 		log.Printf("[DEBUG] Team %s no longer exists", d.Id())
@@ -320,12 +324,12 @@ func resourceTFETeamRead(d *schema.ResourceData, meta interface{}) error {
 
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = helpers.WriteTFEIdentityWithOrg(d, valueOrZero(team.GetId()), organization, config.Client.BaseURL().Host)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	attrs := team.GetAttributes()
@@ -352,7 +356,7 @@ func resourceTFETeamRead(d *schema.ResourceData, meta interface{}) error {
 			"manage_agent_pools":         valueOrZero(organizationAccess.GetManageAgentPools()),
 		}}
 		if err := d.Set("organization_access", organizationAccessData); err != nil {
-			return fmt.Errorf("error setting organization access for team %s: %w", d.Id(), err)
+			return diag.Errorf("error setting organization access for team %s: %s", d.Id(), err)
 		}
 	}
 	if visibility := attrs.GetVisibility(); visibility != nil {
@@ -366,7 +370,7 @@ func resourceTFETeamRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceTFETeamUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the name.
@@ -379,7 +383,7 @@ func resourceTFETeamUpdate(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := d.GetOk("visibility"); ok {
 		visibility, verr := models.ParseTeams_attributes_visibility(v.(string))
 		if verr != nil {
-			return fmt.Errorf("invalid team visibility %q: %w", v.(string), verr)
+			return diag.Errorf("invalid team visibility %q: %s", v.(string), verr)
 		}
 		attributes.SetVisibility(visibility.(*models.Teams_attributes_visibility))
 	}
@@ -403,24 +407,24 @@ func resourceTFETeamUpdate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Update team: %s", d.Id())
 	_, err := config.ClientV2.API.Teams().ById(d.Id()).Patch(ctx, envelope, nil)
 	if err != nil {
-		return fmt.Errorf(
-			"Error updating team %s: %w", d.Id(), err)
+		return diag.Errorf(
+			"Error updating team %s: %s", d.Id(), err)
 	}
 
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = helpers.WriteTFEIdentityWithOrg(d, d.Id(), organization, config.Client.BaseURL().Host)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceTFETeamDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Delete team: %s", d.Id())
@@ -429,7 +433,7 @@ func resourceTFETeamDelete(d *schema.ResourceData, meta interface{}) error {
 		if errors.Is(err, tfe.ErrNotFound) {
 			return nil
 		}
-		return fmt.Errorf("Error deleting team %s: %w", d.Id(), err)
+		return diag.Errorf("Error deleting team %s: %s", d.Id(), err)
 	}
 
 	return nil
@@ -490,10 +494,51 @@ func resourceTFETeamImporter(ctx context.Context, d *schema.ResourceData, meta i
 	}
 
 	// a team does not exist (or cannot be found) with the ID s[1]...check if it is the team name instead
-	team, err := fetchTeamByNameV2(ctx, config.ClientV2.API, orgName, teamNameOrID)
+	team, err := config.lookupTeamByName(ctx, orgName, teamNameOrID)
 	if err != nil {
 		return nil, fmt.Errorf("no team found with name or ID %s in organization %s: %w", teamNameOrID, orgName, err)
 	}
 	d.SetId(valueOrZero(team.GetId()))
 	return []*schema.ResourceData{d}, nil
 }
+
+// teamOrganizationAccessEntitlements are the entitlements needed to grant the
+// organization access permissions that manage paid features.
+var teamOrganizationAccessEntitlements = []struct {
+	permission  string
+	entitlement string
+}{
+	{"manage_policies", "sentinel"},
+	{"manage_policy_overrides", "sentinel"},
+	{"delegate_policy_overrides", "sentinel"},
+	{"manage_providers", "private_module_registry"},
+	{"manage_modules", "private_module_registry"},
+	{"manage_run_tasks", "run_tasks"},
+	{"manage_agent_pools", "agents"},
+}
+
+// customizeDiffTeamFeatureRequirements fails the plan when the organization
+// cannot have teams, or when the team is granted a permission on a feature the
+// organization does not have.
+func customizeDiffTeamFeatureRequirements(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	config := meta.(ConfiguredClient)
+
+	requirements := featureRequirements{feature: "tfe_team"}
+	if d.Id() == "" {
+		requirements.entitlements = append(requirements.entitlements, "teams")
+	}
+
+	for _, access := range teamOrganizationAccessEntitlements {
+		key := "organization_access.0." + access.permission
+		if !d.HasChange(key) || !d.Get(key).(bool) || slices.Contains(requirements.entitlements, access.entitlement) {
+			continue
+		}
+		requirements.entitlements = append(requirements.entitlements, access.entitlement)
+	}
+
+	if len(requirements.entitlements) == 0 {
+		return nil
+	}
+
+	return config.checkFeatureRequirements(ctx, plannedOrganization(d, config), requirements)
+}
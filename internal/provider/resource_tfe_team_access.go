@@ -18,6 +18,7 @@ import (
 	tfe "github.com/hashicorp/go-tfe"
 	tfev2 "github.com/hashicorp/go-tfe/v2"
 	"github.com/hashicorp/go-tfe/v2/api/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -44,10 +45,10 @@ func resourceTFETeamAccess() *schema.Resource {
 		Description: "Manages permissions for a team on a workspace." +
 			"\n\n-> **Note:** At least one of `access` or `permissions` must be provided, but not both. Whichever is omitted will automatically reflect the state of the other.",
 
-		Create: resourceTFETeamAccessCreate,
-		Read:   resourceTFETeamAccessRead,
-		Update: resourceTFETeamAccessUpdate,
-		Delete: resourceTFETeamAccessDelete,
+		CreateWithoutTimeout: resourceTFETeamAccessCreate,
+		ReadWithoutTimeout:   resourceTFETeamAccessRead,
+		UpdateWithoutTimeout: resourceTFETeamAccessUpdate,
+		DeleteWithoutTimeout: resourceTFETeamAccessDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFETeamAccessImporter,
 		},
@@ -277,7 +278,7 @@ func applyTeamWorkspacePermissionAttrs(d *schema.ResourceData, attrs models.Team
 	return nil
 }
 
-func resourceTFETeamAccessCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamAccessCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the access level
@@ -287,33 +288,33 @@ func resourceTFETeamAccessCreate(d *schema.ResourceData, meta interface{}) error
 	workspaceID := d.Get(teamAccessWorkspaceIDKey).(string)
 	ws, err := config.ClientV2.API.Workspaces().ByWorkspace_id(workspaceID).Get(ctx, nil)
 	if err != nil {
-		return fmt.Errorf(
-			"Error retrieving workspace %s: %w", workspaceID, err)
+		return diag.Errorf(
+			"Error retrieving workspace %s: %s", workspaceID, err)
 	}
 	if ws == nil || ws.GetData() == nil {
-		return fmt.Errorf("Error retrieving workspace %s: no data returned", workspaceID)
+		return diag.Errorf("Error retrieving workspace %s: no data returned", workspaceID)
 	}
 
 	// Get the team.
 	teamID := d.Get(teamAccessTeamIDKey).(string)
 	tm, err := config.ClientV2.API.Teams().ById(teamID).Get(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("Error retrieving team %s: %w", teamID, err)
+		return diag.Errorf("Error retrieving team %s: %s", teamID, err)
 	}
 	if tm == nil || tm.GetData() == nil {
-		return fmt.Errorf("Error retrieving team %s: no data returned", teamID)
+		return diag.Errorf("Error retrieving team %s: no data returned", teamID)
 	}
 
 	// Create a new attributes struct.
 	accessValue, aerr := models.ParseTeamWorkspaces_attributes_access(access)
 	if aerr != nil {
-		return fmt.Errorf("invalid team access value %q: %w", access, aerr)
+		return diag.Errorf("invalid team access value %q: %s", access, aerr)
 	}
 	attributes := models.NewTeamWorkspaces_attributes()
 	attributes.SetAccess(accessValue.(*models.TeamWorkspaces_attributes_access))
 
 	if err := applyTeamWorkspacePermissionAttrs(d, attributes, false); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	teamRelationship := models.NewTeamsHasOne()
@@ -346,19 +347,19 @@ func resourceTFETeamAccessCreate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Give team %s %s access to workspace: %s", teamName, access, workspaceName)
 	result, err := config.ClientV2.API.TeamWorkspaces().Post(ctx, envelope, nil)
 	if err != nil {
-		return fmt.Errorf(
-			"Error giving team %s %s access to workspace %s: %w", teamName, access, workspaceName, err)
+		return diag.Errorf(
+			"Error giving team %s %s access to workspace %s: %s", teamName, access, workspaceName, err)
 	}
 	if result == nil || result.GetData() == nil {
-		return fmt.Errorf("Error giving team %s %s access to workspace %s: no data returned", teamName, access, workspaceName)
+		return diag.Errorf("Error giving team %s %s access to workspace %s: no data returned", teamName, access, workspaceName)
 	}
 
 	d.SetId(valueOrZero(result.GetData().GetId()))
 
-	return resourceTFETeamAccessRead(d, meta)
+	return resourceTFETeamAccessRead(ctx, d, meta)
 }
 
-func resourceTFETeamAccessRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamAccessRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read configuration of team access: %s", d.Id())
@@ -369,7 +370,7 @@ func resourceTFETeamAccessRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading configuration of team access %s: %w", d.Id(), err)
+		return diag.Errorf("Error reading configuration of team access %s: %s", d.Id(), err)
 	}
 	if result == nil || result.GetData() == nil {
 		log.Printf("[DEBUG] Team access %s no longer exists", d.Id())
@@ -391,7 +392,7 @@ func resourceTFETeamAccessRead(d *schema.ResourceData, meta interface{}) error {
 		permissionsPolicyOverridesKey:  valueOrZero(attrs.GetPolicyOverrides()),
 	}}
 	if err := d.Set(teamAccessPermissionsKey, permissions); err != nil {
-		return fmt.Errorf("error setting permissions for team access %s: %w", d.Id(), err)
+		return diag.Errorf("error setting permissions for team access %s: %s", d.Id(), err)
 	}
 
 	if relationships := tmAccess.GetRelationships(); relationships != nil && relationships.GetTeam() != nil && relationships.GetTeam().GetData() != nil {
@@ -403,20 +404,20 @@ func resourceTFETeamAccessRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceTFETeamAccessUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamAccessUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Set access level
 	access := d.Get(teamAccessAccessKey).(string)
 	accessValue, aerr := models.ParseTeamWorkspaces_attributes_access(access)
 	if aerr != nil {
-		return fmt.Errorf("invalid team access value %q: %w", access, aerr)
+		return diag.Errorf("invalid team access value %q: %s", access, aerr)
 	}
 	attributes := models.NewTeamWorkspaces_attributes()
 	attributes.SetAccess(accessValue.(*models.TeamWorkspaces_attributes_access))
 
 	if err := applyTeamWorkspacePermissionAttrs(d, attributes, true); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	teamWorkspace := models.NewTeamWorkspaces()
@@ -430,11 +431,11 @@ func resourceTFETeamAccessUpdate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Update team access: %s", d.Id())
 	result, err := config.ClientV2.API.TeamWorkspaces().ByTeam_workspace_id(d.Id()).Patch(ctx, envelope, nil)
 	if err != nil {
-		return fmt.Errorf(
-			"Error updating team access %s: %w", d.Id(), err)
+		return diag.Errorf(
+			"Error updating team access %s: %s", d.Id(), err)
 	}
 	if result == nil || result.GetData() == nil {
-		return fmt.Errorf("Error updating team access %s: no data returned", d.Id())
+		return diag.Errorf("Error updating team access %s: no data returned", d.Id())
 	}
 	updatedAttrs := result.GetData().GetAttributes()
 
@@ -449,13 +450,13 @@ func resourceTFETeamAccessUpdate(d *schema.ResourceData, meta interface{}) error
 		permissionsPolicyOverridesKey:  valueOrZero(updatedAttrs.GetPolicyOverrides()),
 	}}
 	if err := d.Set(teamAccessPermissionsKey, permissions); err != nil {
-		return fmt.Errorf("error setting permissions for team access %s: %w", d.Id(), err)
+		return diag.Errorf("error setting permissions for team access %s: %s", d.Id(), err)
 	}
 
 	return nil
 }
 
-func resourceTFETeamAccessDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamAccessDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Delete team access: %s", d.Id())
@@ -464,7 +465,7 @@ func resourceTFETeamAccessDelete(d *schema.ResourceData, meta interface{}) error
 		if errors.Is(err, tfev2.ErrNotFound) {
 			return nil
 		}
-		return fmt.Errorf("Error deleting team access %s: %w", d.Id(), err)
+		return diag.Errorf("Error deleting team access %s: %s", d.Id(), err)
 	}
 
 	return nil
@@ -482,7 +483,7 @@ func resourceTFETeamAccessImporter(ctx context.Context, d *schema.ResourceData,
 	}
 
 	// Set the fields that are part of the import ID.
-	workspaceID, err := fetchWorkspaceExternalIDV2(s[0]+"/"+s[1], config.ClientV2)
+	workspaceID, err := config.lookupWorkspaceExternalIDV2(ctx, s[0]+"/"+s[1])
 	if err != nil {
 		return nil, fmt.Errorf(
 			"error retrieving workspace %s from organization %s: %w", s[1], s[0], err)
@@ -45,7 +45,7 @@ func resourceTfeTeamAccessResourceV0() *schema.Resource {
 	}
 }
 
-func resourceTfeTeamAccessStateUpgradeV0(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+func resourceTfeTeamAccessStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
 	config := meta.(ConfiguredClient)
 
 	// This state upgrader (schema version 0 -> 1, migrating the legacy
@@ -58,7 +58,7 @@ func resourceTfeTeamAccessStateUpgradeV0(_ context.Context, rawState map[string]
 	// (create, read, update, delete, and the current-version import path)
 	// uses the go-tfe v2 client.
 	humanID := rawState["workspace_id"].(string)
-	id, err := fetchWorkspaceExternalID(humanID, config.Client)
+	id, err := config.lookupWorkspaceExternalID(ctx, humanID)
 	if err != nil {
 		return nil, fmt.Errorf("Error reading configuration of workspace %s: %w", humanID, err)
 	}
@@ -9,12 +9,14 @@
 package provider
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-tfe/internal/provider/helpers"
 )
@@ -24,9 +26,9 @@ func resourceTFETeamMember() *schema.Resource {
 		Description: "Adds or removes a user from a team." +
 			"\n\n~> **Note:** Terraform provides four resources for managing team memberships. `tfe_team_organization_member` and `tfe_team_organization_members` are the preferred resources. `tfe_team_member` can be used multiple times because it manages membership for a single user, while `tfe_team_members` manages all memberships for a team and can be used only once. These four resources cannot be used for the same team simultaneously.",
 
-		Create: resourceTFETeamMemberCreate,
-		Read:   resourceTFETeamMemberRead,
-		Delete: resourceTFETeamMemberDelete,
+		CreateWithoutTimeout: resourceTFETeamMemberCreate,
+		ReadWithoutTimeout:   resourceTFETeamMemberRead,
+		DeleteWithoutTimeout: resourceTFETeamMemberDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughWithIdentity("id"),
 		},
@@ -70,7 +72,7 @@ func resourceTFETeamMember() *schema.Resource {
 	}
 }
 
-func resourceTFETeamMemberCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the team ID and username..
@@ -80,7 +82,7 @@ func resourceTFETeamMemberCreate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Add user %q to team: %s", username, teamID)
 	err := teamMembersAddUsersV2(ctx, config.ClientV2.API, teamID, []string{username})
 	if err != nil {
-		return fmt.Errorf("Error adding user %q to team %s: %w", username, teamID, err)
+		return diag.Errorf("Error adding user %q to team %s: %s", username, teamID, err)
 	}
 
 	memberID := packTeamMemberID(teamID, username)
@@ -88,19 +90,19 @@ func resourceTFETeamMemberCreate(d *schema.ResourceData, meta interface{}) error
 
 	err = helpers.WriteTFEIdentity(d, memberID, config.Client.BaseURL().Host)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceTFETeamMemberRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the team ID and username.
 	teamID, username, err := unpackTeamMemberID(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error unpacking team member ID: %w", err)
+		return diag.Errorf("Error unpacking team member ID: %s", err)
 	}
 
 	log.Printf("[DEBUG] Read users from team: %s", teamID)
@@ -111,7 +113,7 @@ func resourceTFETeamMemberRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading users from team %s: %w", teamID, err)
+		return diag.Errorf("Error reading users from team %s: %s", teamID, err)
 	}
 
 	found := false
@@ -126,7 +128,7 @@ func resourceTFETeamMemberRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId(memberID)
 			err = helpers.WriteTFEIdentity(d, memberID, config.Client.BaseURL().Host)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			found = true
 			break
@@ -141,19 +143,19 @@ func resourceTFETeamMemberRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceTFETeamMemberDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the team ID and username.
 	teamID, username, err := unpackTeamMemberID(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error unpacking team member ID: %w", err)
+		return diag.Errorf("Error unpacking team member ID: %s", err)
 	}
 
 	log.Printf("[DEBUG] Remove user %q from team: %s", username, teamID)
 	err = teamMembersRemoveUsersV2(ctx, config.ClientV2.API, teamID, []string{username})
 	if err != nil {
-		return fmt.Errorf("Error removing user %q to team %s: %w", username, teamID, err)
+		return diag.Errorf("Error removing user %q to team %s: %s", username, teamID, err)
 	}
 
 	return nil
@@ -11,10 +11,10 @@ package provider
 import (
 	"context"
 	"errors"
-	"fmt"
 	"log"
 
 	tfe "github.com/hashicorp/go-tfe/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -23,10 +23,10 @@ func resourceTFETeamMembers() *schema.Resource {
 		Description: "Manages users in a team." +
 			"\n\n~> **Note:** Terraform provides four resources for managing team memberships. `tfe_team_organization_member` and `tfe_team_organization_members` are the preferred resources. `tfe_team_member` can be used multiple times because it manages membership for a single user, while `tfe_team_members` manages all memberships for a team and can be used only once. These four resources cannot be used for the same team simultaneously.",
 
-		Create: resourceTFETeamMembersCreate,
-		Read:   resourceTFETeamMembersRead,
-		Update: resourceTFETeamMembersUpdate,
-		Delete: resourceTFETeamMembersDelete,
+		CreateWithoutTimeout: resourceTFETeamMembersCreate,
+		ReadWithoutTimeout:   resourceTFETeamMembersRead,
+		UpdateWithoutTimeout: resourceTFETeamMembersUpdate,
+		DeleteWithoutTimeout: resourceTFETeamMembersDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFETeamMembersImporter,
 		},
@@ -55,7 +55,7 @@ func resourceTFETeamMembers() *schema.Resource {
 	}
 }
 
-func resourceTFETeamMembersCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamMembersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the team ID.
@@ -67,7 +67,7 @@ func resourceTFETeamMembersCreate(d *schema.ResourceData, meta interface{}) erro
 	log.Printf("[DEBUG] Add users to team: %s", teamID)
 	err := teamMembersAddUsersV2(ctx, config.ClientV2.API, teamID, usernames)
 	if err != nil {
-		return fmt.Errorf("Error adding users to team %s: %w", teamID, err)
+		return diag.Errorf("Error adding users to team %s: %s", teamID, err)
 	}
 
 	d.SetId(teamID)
@@ -75,7 +75,7 @@ func resourceTFETeamMembersCreate(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceTFETeamMembersRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read users from team: %s", d.Id())
@@ -86,7 +86,7 @@ func resourceTFETeamMembersRead(d *schema.ResourceData, meta interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading users from team %s: %w", d.Id(), err)
+		return diag.Errorf("Error reading users from team %s: %s", d.Id(), err)
 	}
 
 	var usernames []interface{}
@@ -104,7 +104,7 @@ func resourceTFETeamMembersRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceTFETeamMembersUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamMembersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	if d.HasChange("usernames") {
@@ -117,7 +117,7 @@ func resourceTFETeamMembersUpdate(d *schema.ResourceData, meta interface{}) erro
 			log.Printf("[DEBUG] Add users to team: %s", d.Id())
 			err := teamMembersAddUsersV2(ctx, config.ClientV2.API, d.Id(), schemaSetToStringSlice(newUsers))
 			if err != nil {
-				return fmt.Errorf("Error adding users to team %s: %w", d.Id(), err)
+				return diag.Errorf("Error adding users to team %s: %s", d.Id(), err)
 			}
 		}
 
@@ -126,7 +126,7 @@ func resourceTFETeamMembersUpdate(d *schema.ResourceData, meta interface{}) erro
 			log.Printf("[DEBUG] Remove users from team: %s", d.Id())
 			err := teamMembersRemoveUsersV2(ctx, config.ClientV2.API, d.Id(), schemaSetToStringSlice(oldUsers))
 			if err != nil {
-				return fmt.Errorf("Error removing users to team %s: %w", d.Id(), err)
+				return diag.Errorf("Error removing users to team %s: %s", d.Id(), err)
 			}
 		}
 	}
@@ -134,7 +134,7 @@ func resourceTFETeamMembersUpdate(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceTFETeamMembersDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamMembersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Retrieve users to remove from team: %s", d.Id())
@@ -143,13 +143,13 @@ func resourceTFETeamMembersDelete(d *schema.ResourceData, meta interface{}) erro
 		if errors.Is(err, tfe.ErrNotFound) {
 			return nil
 		}
-		return fmt.Errorf("Error retrieving users to remove from team %s: %w", d.Id(), err)
+		return diag.Errorf("Error retrieving users to remove from team %s: %s", d.Id(), err)
 	}
 
 	log.Printf("[DEBUG] Remove users from team: %s", d.Id())
 	err = teamMembersRemoveUsersV2(ctx, config.ClientV2.API, d.Id(), users)
 	if err != nil {
-		return fmt.Errorf("Error removing users from team %s: %w", d.Id(), err)
+		return diag.Errorf("Error removing users from team %s: %s", d.Id(), err)
 	}
 
 	return nil
@@ -16,6 +16,7 @@ import (
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -26,9 +27,9 @@ func resourceTFETeamOrganizationMember() *schema.Resource {
 			"\n\n~> **Note** on managing team memberships: Terraform currently provides four resources for managing team memberships. This - along with [tfe_organization_membership](organization_membership.html) - is the preferred method as it allows you to add members to a team by email addresses. The [tfe_team_organization_member](team_organization_member.html) is used to manage a single team membership whereas [tfe_team_organization_members](team_organization_members.html) is used to manage all team memberships at once. All four resources cannot be used for the same team simultaneously." +
 			"\n\n-> **Note:** The `<ORGANIZATION NAME>/<USER EMAIL>/<TEAM NAME>` import ID format cannot be used if there are `/` characters in the user's email. Use `<TEAM ID>/<ORGANIZATION MEMBERSHIP ID>` in that case.",
 
-		Create: resourceTFETeamOrganizationMemberCreate,
-		Read:   resourceTFETeamOrganizationMemberRead,
-		Delete: resourceTFETeamOrganizationMemberDelete,
+		CreateWithoutTimeout: resourceTFETeamOrganizationMemberCreate,
+		ReadWithoutTimeout:   resourceTFETeamOrganizationMemberRead,
+		DeleteWithoutTimeout: resourceTFETeamOrganizationMemberDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFETeamOrganizationMemberImporter,
 		},
@@ -57,7 +58,7 @@ func resourceTFETeamOrganizationMember() *schema.Resource {
 	}
 }
 
-func resourceTFETeamOrganizationMemberCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamOrganizationMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the team ID and organization membership ID.
@@ -67,7 +68,7 @@ func resourceTFETeamOrganizationMemberCreate(d *schema.ResourceData, meta interf
 	log.Printf("[DEBUG] Add organization membership %q to team: %s", organizationMembershipID, teamID)
 	err := teamMembersAddOrgMembershipsV2(ctx, config.ClientV2.API, teamID, []string{organizationMembershipID})
 	if err != nil {
-		return fmt.Errorf("Error adding organization membership %q to team %s: %w", organizationMembershipID, teamID, err)
+		return diag.Errorf("Error adding organization membership %q to team %s: %s", organizationMembershipID, teamID, err)
 	}
 
 	d.SetId(packTeamOrganizationMemberID(teamID, organizationMembershipID))
@@ -75,13 +76,13 @@ func resourceTFETeamOrganizationMemberCreate(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceTFETeamOrganizationMemberRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamOrganizationMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the team ID and organization membership id.
 	teamID, organizationMembershipID, err := unpackTeamOrganizationMemberID(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error unpacking team member ID: %w", err)
+		return diag.Errorf("Error unpacking team member ID: %s", err)
 	}
 
 	log.Printf("[DEBUG] Read organization membership from team: %s", teamID)
@@ -92,7 +93,7 @@ func resourceTFETeamOrganizationMemberRead(d *schema.ResourceData, meta interfac
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading organization memberships from team %s: %w", teamID, err)
+		return diag.Errorf("Error reading organization memberships from team %s: %s", teamID, err)
 	}
 
 	found := false
@@ -114,19 +115,19 @@ func resourceTFETeamOrganizationMemberRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceTFETeamOrganizationMemberDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamOrganizationMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the team ID and organization membership id.
 	teamID, organizationMembershipID, err := unpackTeamOrganizationMemberID(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error unpacking team member ID: %w", err)
+		return diag.Errorf("Error unpacking team member ID: %s", err)
 	}
 
 	log.Printf("[DEBUG] Remove organization membership %q from team: %s", organizationMembershipID, teamID)
 	err = teamMembersRemoveOrgMembershipsV2(ctx, config.ClientV2.API, teamID, []string{organizationMembershipID})
 	if err != nil {
-		return fmt.Errorf("Error removing organization membership %q to team %s: %w", organizationMembershipID, teamID, err)
+		return diag.Errorf("Error removing organization membership %q to team %s: %s", organizationMembershipID, teamID, err)
 	}
 
 	return nil
@@ -163,12 +164,12 @@ func resourceTFETeamOrganizationMemberImporter(ctx context.Context, d *schema.Re
 		org := s[0]
 		email := s[1]
 		teamName := s[2]
-		orgMembership, err := fetchOrganizationMemberByNameOrEmailV2(ctx, config.ClientV2, org, "", email)
+		orgMembership, err := config.lookupOrganizationMemberByNameOrEmailV2(ctx, org, "", email)
 		if err != nil {
 			return nil, fmt.Errorf(
 				"error retrieving user with email %s from organization %s: %w", email, org, err)
 		}
-		team, err := fetchTeamByNameV2(ctx, config.ClientV2.API, org, teamName)
+		team, err := config.lookupTeamByName(ctx, org, teamName)
 		if err != nil {
 			return nil, fmt.Errorf(
 				"error retrieving team with name %s from organization %s: %w", teamName, org, err)
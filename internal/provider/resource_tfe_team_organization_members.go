@@ -17,6 +17,7 @@ import (
 	tfe "github.com/hashicorp/go-tfe/v2"
 	v2api "github.com/hashicorp/go-tfe/v2/api"
 	"github.com/hashicorp/go-tfe/v2/api/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -26,10 +27,10 @@ func resourceTFETeamOrganizationMembers() *schema.Resource {
 			"\n\n~> **Note:** Terraform provides four resources for managing team memberships. This resource, along with `tfe_team_organization_member`, is the preferred approach because memberships are managed via organization memberships. `tfe_team_organization_member` manages a single membership, while `tfe_team_organization_members` manages all memberships for a team. These four resources cannot be used for the same team simultaneously." +
 			"\n\n~> **Note:** This resource requires using the provider with HCP Terraform or Terraform Enterprise at least as recent as v202004-1.",
 
-		Create: resourceTFETeamOrganizationMembersCreate,
-		Read:   resourceTFETeamOrganizationMembersRead,
-		Update: resourceTFETeamOrganizationMembersUpdate,
-		Delete: resourceTFETeamOrganizationMembersDelete,
+		CreateWithoutTimeout: resourceTFETeamOrganizationMembersCreate,
+		ReadWithoutTimeout:   resourceTFETeamOrganizationMembersRead,
+		UpdateWithoutTimeout: resourceTFETeamOrganizationMembersUpdate,
+		DeleteWithoutTimeout: resourceTFETeamOrganizationMembersDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -58,7 +59,7 @@ func resourceTFETeamOrganizationMembers() *schema.Resource {
 	}
 }
 
-func resourceTFETeamOrganizationMembersCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamOrganizationMembersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the team ID.
@@ -69,7 +70,7 @@ func resourceTFETeamOrganizationMembersCreate(d *schema.ResourceData, meta inter
 	log.Printf("[DEBUG] Add organization memberships %v to team: %s", organizationMembershipIDs, teamID)
 	err := teamMembersAddOrgMembershipsV2(ctx, config.ClientV2.API, teamID, organizationMembershipIDs)
 	if err != nil {
-		return fmt.Errorf("Error adding organization memberships %v to team %s: %w", organizationMembershipIDs, teamID, err)
+		return diag.Errorf("Error adding organization memberships %v to team %s: %s", organizationMembershipIDs, teamID, err)
 	}
 
 	d.SetId(teamID)
@@ -77,7 +78,7 @@ func resourceTFETeamOrganizationMembersCreate(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTFETeamOrganizationMembersRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamOrganizationMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read organization memberships from team: %s", d.Id())
@@ -88,13 +89,13 @@ func resourceTFETeamOrganizationMembersRead(d *schema.ResourceData, meta interfa
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading organization memberships from team %s: %w", d.Id(), err)
+		return diag.Errorf("Error reading organization memberships from team %s: %s", d.Id(), err)
 	}
 
 	log.Printf("[DEBUG] Read users from team: %s", d.Id())
 	nonServiceAccountOrganizationMemberships, err := filterNonServiceAccountOrganizationMembersV2(ctx, config.ClientV2.API, organizationMemberships)
 	if err != nil {
-		return fmt.Errorf("Error reading users from team %s: %w", d.Id(), err)
+		return diag.Errorf("Error reading users from team %s: %s", d.Id(), err)
 	}
 
 	// Get all organization memberships and add them to object
@@ -155,7 +156,7 @@ func fetchExistingTeamMembershipIdsV2(ctx context.Context, api *v2api.ApiClient,
 	return teamMembersIDSet, nil
 }
 
-func resourceTFETeamOrganizationMembersUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamOrganizationMembersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	var membershipIDsToDelete *schema.Set
@@ -173,7 +174,7 @@ func resourceTFETeamOrganizationMembersUpdate(d *schema.ResourceData, meta inter
 		log.Printf("[DEBUG] Add organization memberships %v to team: %s", idsToAdd, d.Id())
 		err := teamMembersAddOrgMembershipsV2(ctx, config.ClientV2.API, d.Id(), idsToAdd)
 		if err != nil {
-			return fmt.Errorf("Error adding organization memberships to team %s: %w", d.Id(), err)
+			return diag.Errorf("Error adding organization memberships to team %s: %s", d.Id(), err)
 		}
 	}
 
@@ -184,7 +185,7 @@ func resourceTFETeamOrganizationMembersUpdate(d *schema.ResourceData, meta inter
 	// Then delete all the old users.
 	existingIDs, err := fetchExistingTeamMembershipIdsV2(ctx, config.ClientV2.API, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Add all the organization memberships that need to be removed, except the
@@ -201,7 +202,7 @@ func resourceTFETeamOrganizationMembersUpdate(d *schema.ResourceData, meta inter
 		log.Printf("[DEBUG] Remove organization memberships %v from team: %s", idsToDelete, d.Id())
 		err = teamMembersRemoveOrgMembershipsV2(ctx, config.ClientV2.API, d.Id(), idsToDelete)
 		if err != nil {
-			return fmt.Errorf("Error removing organization memberships from team %s: %w", d.Id(), err)
+			return diag.Errorf("Error removing organization memberships from team %s: %s", d.Id(), err)
 		}
 	} else {
 		log.Printf("[DEBUG] All members planned to be removed from this team were already removed from team %s", d.Id())
@@ -210,7 +211,7 @@ func resourceTFETeamOrganizationMembersUpdate(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTFETeamOrganizationMembersDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFETeamOrganizationMembersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read organization memberships from team: %s", d.Id())
@@ -221,12 +222,12 @@ func resourceTFETeamOrganizationMembersDelete(d *schema.ResourceData, meta inter
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading organization memberships from team %s: %w", d.Id(), err)
+		return diag.Errorf("Error reading organization memberships from team %s: %s", d.Id(), err)
 	}
 
 	nonServiceAccountOrganizationMemberships, err := filterNonServiceAccountOrganizationMembersV2(ctx, config.ClientV2.API, organizationMemberships)
 	if err != nil {
-		return fmt.Errorf("Error fetching account user IDs for team %s: %w", d.Id(), err)
+		return diag.Errorf("Error fetching account user IDs for team %s: %s", d.Id(), err)
 	}
 
 	var idsToRemove []string
@@ -237,7 +238,7 @@ func resourceTFETeamOrganizationMembersDelete(d *schema.ResourceData, meta inter
 	log.Printf("[DEBUG] Remove organization memberships %v from team: %s", idsToRemove, d.Id())
 	err = teamMembersRemoveOrgMembershipsV2(ctx, config.ClientV2.API, d.Id(), idsToRemove)
 	if err != nil {
-		return fmt.Errorf("Error removing organization membership %v to team %s: %w", idsToRemove, d.Id(), err)
+		return diag.Errorf("Error removing organization membership %v to team %s: %s", idsToRemove, d.Id(), err)
 	}
 
 	return nil
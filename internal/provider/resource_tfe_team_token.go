@@ -32,6 +32,7 @@ import (
 var (
 	_ resource.ResourceWithConfigure   = &resourceTFETeamToken{}
 	_ resource.ResourceWithImportState = &resourceTFETeamToken{}
+	_ resource.ResourceWithModifyPlan  = &resourceTFETeamToken{}
 )
 
 func NewTeamTokenResource() resource.Resource {
@@ -70,6 +71,10 @@ func (r *resourceTFETeamToken) Metadata(_ context.Context, req resource.Metadata
 	resp.TypeName = req.ProviderTypeName + "_team_token"
 }
 
+func (r *resourceTFETeamToken) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanForReadOnly(r.config.ReadOnly, req, resp)
+}
+
 func (r *resourceTFETeamToken) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Generates a new team token. If no description is provided, it follows the legacy behavior to override the existing, descriptionless token if one exists.",
@@ -411,7 +411,7 @@ func (r *terraformVersionResource) ImportState(ctx context.Context, req resource
 	// determines if the string is a tool version ID
 	s := strings.Split(req.ID, "-")
 	if s[0] != "tool" {
-		versionID, err := fetchTerraformVersionID(req.ID, r.config.Client)
+		versionID, err := r.config.lookupTerraformVersionID(ctx, req.ID)
 		tflog.Debug(ctx, "Importing Terraform version", map[string]interface{}{
 			"version_id": versionID,
 		})
@@ -841,7 +841,7 @@ func (r *resourceTFEVariable) UpgradeState(ctx context.Context) map[int64]resour
 				}
 				// Get the workspace external ID
 				oldWorkspaceID := oldData.WorkspaceID.ValueString()
-				newWorkspaceID, err := fetchWorkspaceExternalID(oldWorkspaceID, r.config.Client)
+				newWorkspaceID, err := r.config.lookupWorkspaceExternalID(ctx, oldWorkspaceID)
 				if err != nil {
 					resp.Diagnostics.AddError(
 						"Error reading workspace",
@@ -955,7 +955,7 @@ func (r *resourceTFEVariable) legacyImportByID(ctx context.Context, req resource
 	if varsetIDUsed {
 		data.VariableSetID = types.StringValue(container)
 	} else {
-		workspaceID, err := fetchWorkspaceExternalID(organization+"/"+container, r.config.Client)
+		workspaceID, err := r.config.lookupWorkspaceExternalID(ctx, organization+"/"+container)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error importing variable",
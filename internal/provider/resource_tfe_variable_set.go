@@ -15,6 +15,7 @@ import (
 	"regexp"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-tfe/internal/provider/helpers"
 )
@@ -25,10 +26,10 @@ func resourceTFEVariableSet() *schema.Resource {
 	return &schema.Resource{
 		Description: "Creates, updates and destroys variable sets.",
 
-		Create: resourceTFEVariableSetCreate,
-		Read:   resourceTFEVariableSetRead,
-		Update: resourceTFEVariableSetUpdate,
-		Delete: resourceTFEVariableSetDelete,
+		CreateWithoutTimeout: resourceTFEVariableSetCreate,
+		ReadWithoutTimeout:   resourceTFEVariableSetRead,
+		UpdateWithoutTimeout: resourceTFEVariableSetUpdate,
+		DeleteWithoutTimeout: resourceTFEVariableSetDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughWithIdentity("id"),
 		},
@@ -129,14 +130,14 @@ func resourceTFEVariableSet() *schema.Resource {
 	}
 }
 
-func resourceTFEVariableSetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEVariableSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the name and organization.
 	name := d.Get("name").(string)
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Create a new options struct.
@@ -160,8 +161,8 @@ func resourceTFEVariableSetCreate(d *schema.ResourceData, meta interface{}) erro
 
 	variableSet, err := config.Client.VariableSets.Create(ctx, organization, &options)
 	if err != nil {
-		return fmt.Errorf(
-			"Error creating variable set %s, for organization: %s: %w", name, organization, err)
+		return diag.Errorf(
+			"Error creating variable set %s, for organization: %s: %s", name, organization, err)
 	}
 
 	d.SetId(variableSet.ID)
@@ -179,8 +180,8 @@ func resourceTFEVariableSetCreate(d *schema.ResourceData, meta interface{}) erro
 
 		_, err := config.Client.VariableSets.UpdateWorkspaces(ctx, variableSet.ID, &applyOptions)
 		if err != nil {
-			return fmt.Errorf(
-				"Error applying variable set %s (%s) to given workspaces: %w", name, variableSet.ID, err)
+			return diag.Errorf(
+				"Error applying variable set %s (%s) to given workspaces: %s", name, variableSet.ID, err)
 		}
 	}
 
@@ -196,20 +197,20 @@ func resourceTFEVariableSetCreate(d *schema.ResourceData, meta interface{}) erro
 
 		_, err := config.Client.VariableSets.UpdateStacks(ctx, variableSet.ID, &applyOptions)
 		if err != nil {
-			return fmt.Errorf(
-				"Error applying variable set %s (%s) to given stacks: %w", name, variableSet.ID, err)
+			return diag.Errorf(
+				"Error applying variable set %s (%s) to given stacks: %s", name, variableSet.ID, err)
 		}
 	}
 
 	err = helpers.WriteTFEIdentity(d, variableSet.ID, config.Client.BaseURL().Host)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceTFEVariableSetRead(d, meta)
+	return resourceTFEVariableSetRead(ctx, d, meta)
 }
 
-func resourceTFEVariableSetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEVariableSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Read configuration of variable set: %s", d.Id())
@@ -218,7 +219,7 @@ func resourceTFEVariableSetRead(d *schema.ResourceData, meta interface{}) error
 	meetsMinVersionRequirement, err := config.MeetsMinRemoteTFEVersion(minTFEVersionVariableSetStacks)
 	if err != nil {
 		log.Printf("[DEBUG] could not determine if TFE version meets minimum required version %s: %v", minTFEVersionVariableSetStacks, err)
-		return fmt.Errorf("Error while determining TFE version compatibility: %w", err)
+		return diag.Errorf("Error while determining TFE version compatibility: %s", err)
 	} else if meetsMinVersionRequirement {
 		includes = append(includes, tfe.VariableSetStacks)
 	}
@@ -232,7 +233,7 @@ func resourceTFEVariableSetRead(d *schema.ResourceData, meta interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading configuration of variable set %s: %w", d.Id(), err)
+		return diag.Errorf("Error reading configuration of variable set %s: %s", d.Id(), err)
 	}
 
 	// Update the config.
@@ -260,13 +261,13 @@ func resourceTFEVariableSetRead(d *schema.ResourceData, meta interface{}) error
 
 	err = helpers.WriteTFEIdentity(d, variableSet.ID, config.Client.BaseURL().Host)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceTFEVariableSetUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEVariableSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	if d.HasChange("name") || d.HasChange("description") || d.HasChange("global") || d.HasChange("priority") {
@@ -280,7 +281,7 @@ func resourceTFEVariableSetUpdate(d *schema.ResourceData, meta interface{}) erro
 		log.Printf("[DEBUG] Update variable set: %s", d.Id())
 		_, err := config.Client.VariableSets.Update(ctx, d.Id(), &options)
 		if err != nil {
-			return fmt.Errorf("Error updating variable %s: %w", d.Id(), err)
+			return diag.Errorf("Error updating variable %s: %s", d.Id(), err)
 		}
 	}
 
@@ -298,8 +299,8 @@ func resourceTFEVariableSetUpdate(d *schema.ResourceData, meta interface{}) erro
 		warnWorkspaceIdsDeprecation()
 		_, err := config.Client.VariableSets.UpdateWorkspaces(ctx, d.Id(), &applyOptions)
 		if err != nil {
-			return fmt.Errorf(
-				"Error applying variable set %s to given workspaces: %w", d.Id(), err)
+			return diag.Errorf(
+				"Error applying variable set %s to given workspaces: %s", d.Id(), err)
 		}
 	}
 
@@ -316,15 +317,15 @@ func resourceTFEVariableSetUpdate(d *schema.ResourceData, meta interface{}) erro
 		log.Printf("[DEBUG] Apply variable set %s to stacks %v", d.Id(), stackIDs)
 		_, err := config.Client.VariableSets.UpdateStacks(ctx, d.Id(), &applyOptions)
 		if err != nil {
-			return fmt.Errorf(
-				"Error applying variable set %s to given stacks: %w", d.Id(), err)
+			return diag.Errorf(
+				"Error applying variable set %s to given stacks: %s", d.Id(), err)
 		}
 	}
 
-	return resourceTFEVariableSetRead(d, meta)
+	return resourceTFEVariableSetRead(ctx, d, meta)
 }
 
-func resourceTFEVariableSetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEVariableSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	log.Printf("[DEBUG] Delete variable set: %s", d.Id())
@@ -333,7 +334,7 @@ func resourceTFEVariableSetDelete(d *schema.ResourceData, meta interface{}) erro
 		if err == tfe.ErrResourceNotFound {
 			return nil
 		}
-		return fmt.Errorf("Error deleting variable set %s: %w", d.Id(), err)
+		return diag.Errorf("Error deleting variable set %s: %s", d.Id(), err)
 	}
 
 	return nil
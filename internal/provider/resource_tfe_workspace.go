@@ -20,6 +20,7 @@ import (
 
 	tfe "github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/jsonapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -34,14 +35,18 @@ func resourceTFEWorkspace() *schema.Resource {
 			"\n\n~> **Note:** Setting the execution mode and agent pool affinity directly on the workspace is deprecated in favor of using both [tfe_workspace_settings](workspace_settings) and [tfe_organization_default_settings](organization_default_settings), since they allow more precise control and fully support [agent_pool_allowed_workspaces](agent_pool_allowed_workspaces). Use caution when unsetting `execution_mode`, as it now leaves any prior value unmanaged instead of reverting to the old default value of `\"remote\"`." +
 			"\n\n-> **Note:** `auto_destroy_at` is not intended for workspaces containing production resources or long-lived workspaces. Since this attribute is in-part managed by HCP Terraform, using `ignore_changes` for this attribute may be preferred.",
 
-		Create: resourceTFEWorkspaceCreate,
-		Read:   resourceTFEWorkspaceRead,
-		Update: resourceTFEWorkspaceUpdate,
-		Delete: resourceTFEWorkspaceDelete,
+		CreateWithoutTimeout: resourceTFEWorkspaceCreate,
+		ReadWithoutTimeout:   resourceTFEWorkspaceRead,
+		UpdateWithoutTimeout: resourceTFEWorkspaceUpdate,
+		DeleteWithoutTimeout: resourceTFEWorkspaceDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFEWorkspaceImporter,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
 			{
@@ -64,6 +69,10 @@ func resourceTFEWorkspace() *schema.Resource {
 				return err
 			}
 
+			if err := customizeDiffDefaultTags(c, d, meta); err != nil {
+				return err
+			}
+
 			if err := customizeDiffAutoDestroyAt(c, d); err != nil {
 				return err
 			}
@@ -324,6 +333,15 @@ func resourceTFEWorkspace() *schema.Resource {
 				Description: "A map of key value tags for this workspace, including any tags inherited from the parent project.",
 			},
 
+			"tags_all": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "A map of key value tags set on this workspace, including the provider `default_tags`.",
+			},
+
 			"terraform_version": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -430,14 +448,14 @@ func resourceTFEWorkspace() *schema.Resource {
 	}
 }
 
-func resourceTFEWorkspaceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEWorkspaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	// Get the name and organization.
 	name := d.Get("name").(string)
 	organization, err := config.schemaOrDefaultOrganization(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Create a new options struct.
@@ -481,7 +499,7 @@ func resourceTFEWorkspaceCreate(d *schema.ResourceData, meta interface{}) error
 	if _, ok := d.GetOk("auto_destroy_at"); ok {
 		autoDestroyAt, err := expandAutoDestroyAt(d)
 		if err != nil {
-			return fmt.Errorf("Error expanding auto destroy during create: %w", err)
+			return diag.Errorf("Error expanding auto destroy during create: %s", err)
 		}
 		options.AutoDestroyAt = autoDestroyAt
 	}
@@ -522,10 +540,10 @@ func resourceTFEWorkspaceCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if tagBindings, ok := d.Get("tags").(map[string]interface{}); ok {
-		for key, val := range tagBindings {
+		for key, val := range helpers.MergeDefaultTags(config.DefaultTags, helpers.StringMap(tagBindings)) {
 			options.TagBindings = append(options.TagBindings, &tfe.TagBinding{
 				Key:   key,
-				Value: val.(string),
+				Value: val,
 			})
 		}
 	}
@@ -593,15 +611,15 @@ func resourceTFEWorkspaceCreate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Create workspace %s for organization: %s", name, organization)
 	workspace, err := config.Client.Workspaces.Create(ctx, organization, options)
 	if err != nil {
-		return fmt.Errorf(
-			"Error creating workspace %s for organization %s: %w", name, organization, err)
+		return diag.Errorf(
+			"Error creating workspace %s for organization %s: %s", name, organization, err)
 	}
 
 	d.SetId(workspace.ID)
 
 	err = helpers.WriteTFEIdentity(d, workspace.ID, config.Client.BaseURL().Host)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if sshKeyID, ok := d.GetOk("ssh_key_id"); ok {
@@ -609,7 +627,7 @@ func resourceTFEWorkspaceCreate(d *schema.ResourceData, meta interface{}) error
 			SSHKeyID: tfe.String(sshKeyID.(string)),
 		})
 		if err != nil {
-			return fmt.Errorf("Error assigning SSH key to workspace %s: %w", name, err)
+			return diag.Errorf("Error assigning SSH key to workspace %s: %s", name, err)
 		}
 	}
 
@@ -621,14 +639,14 @@ func resourceTFEWorkspaceCreate(d *schema.ResourceData, meta interface{}) error
 		}
 		err = config.Client.Workspaces.AddRemoteStateConsumers(ctx, workspace.ID, options)
 		if err != nil {
-			return fmt.Errorf("Error adding remote state consumers to workspace %s: %w", name, err)
+			return diag.Errorf("Error adding remote state consumers to workspace %s: %s", name, err)
 		}
 	}
 
-	return resourceTFEWorkspaceRead(d, meta)
+	return resourceTFEWorkspaceRead(ctx, d, meta)
 }
 
-func resourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	id := d.Id()
@@ -649,16 +667,16 @@ func resourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
 			d.SetId("")
 			return nil
 		} else if err != nil {
-			return fmt.Errorf("Error reading workspace %s without include: %w", id, err)
+			return diag.Errorf("Error reading workspace %s without include: %s", id, err)
 		}
 	}
 	if err != nil {
-		return fmt.Errorf("Error reading configuration of workspace %s: %w", id, err)
+		return diag.Errorf("Error reading configuration of workspace %s: %s", id, err)
 	}
 
 	err = helpers.WriteTFEIdentity(d, workspace.ID, config.Client.BaseURL().Host)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Given this computed attribute will be null when tag bindings are not
@@ -666,7 +684,15 @@ func resourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
 	// changes on this attribute.
 	d.Set("effective_tags", map[string]interface{}{})
 
-	tagInfo := helpers.NewTagInfo(d.Get("tags").(map[string]interface{}), workspace.EffectiveTagBindings, d.Get("ignore_additional_tags").(bool))
+	// The tags managed by this resource include the provider default tags,
+	// which are reported in tags_all but left out of tags.
+	configuredTags := helpers.StringMap(d.Get("tags").(map[string]interface{}))
+	managedTagBindings := d.Get("tags_all").(map[string]interface{})
+	for key, val := range configuredTags {
+		managedTagBindings[key] = val
+	}
+	tagInfo := helpers.NewTagInfo(managedTagBindings, workspace.EffectiveTagBindings, d.Get("ignore_additional_tags").(bool))
+	tags := helpers.RemoveDefaultTags(config.DefaultTags, helpers.StringMap(tagInfo.SelfTags), configuredTags)
 
 	// Update the config.
 	d.Set("name", workspace.Name)
@@ -688,7 +714,8 @@ func resourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("source_url", workspace.SourceURL)
 	d.Set("speculative_enabled", workspace.SpeculativeEnabled)
 	d.Set("structured_run_output_enabled", workspace.StructuredRunOutputEnabled)
-	d.Set("tags", tagInfo.SelfTags)
+	d.Set("tags", tags)
+	d.Set("tags_all", tagInfo.SelfTags)
 	d.Set("terraform_version", workspace.TerraformVersion)
 	d.Set("trigger_prefixes", workspace.TriggerPrefixes)
 	d.Set("trigger_patterns", workspace.TriggerPatterns)
@@ -728,14 +755,14 @@ func resourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
 
 	autoDestroyAt, err := flattenAutoDestroyAt(workspace.AutoDestroyAt)
 	if err != nil {
-		return fmt.Errorf("Error flattening auto destroy during read: %w", err)
+		return diag.Errorf("Error flattening auto destroy during read: %s", err)
 	}
 	d.Set("auto_destroy_at", autoDestroyAt)
 
 	if workspace.AutoDestroyActivityDuration.IsSpecified() {
 		v, err := workspace.AutoDestroyActivityDuration.Get()
 		if err != nil {
-			return fmt.Errorf("Error reading auto destroy activity duration: %w", err)
+			return diag.Errorf("Error reading auto destroy activity duration: %s", err)
 		}
 		d.Set("auto_destroy_activity_duration", v)
 	} else {
@@ -769,10 +796,10 @@ func resourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
 	if workspace.GlobalRemoteState {
 		d.Set("global_remote_state", true)
 	} else {
-		globalRemoteState, remoteStateConsumerIDs, err := readWorkspaceStateConsumers(id, config.Client)
+		globalRemoteState, remoteStateConsumerIDs, err := readWorkspaceStateConsumers(ctx, id, config.Client)
 		if err != nil {
-			return fmt.Errorf(
-				"Error reading remote state consumers for workspace %s: %w", id, err)
+			return diag.Errorf(
+				"Error reading remote state consumers for workspace %s: %s", id, err)
 		}
 
 		d.Set("global_remote_state", globalRemoteState)
@@ -782,7 +809,7 @@ func resourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEWorkspaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 	id := d.Id()
 
@@ -795,7 +822,8 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 		d.HasChange("description") || d.HasChange("agent_pool_id") ||
 		d.HasChange("global_remote_state") || d.HasChange("structured_run_output_enabled") ||
 		d.HasChange("assessments_enabled") || d.HasChange("project_id") ||
-		hasAutoDestroyAtChange(d) || d.HasChange("auto_destroy_activity_duration") {
+		hasAutoDestroyAtChange(d) || d.HasChange("auto_destroy_activity_duration") ||
+		d.HasChange("tags_all") {
 		// Create a new options struct.
 		options := tfe.WorkspaceUpdateOptions{
 			Name:                       tfe.String(d.Get("name").(string)),
@@ -861,7 +889,7 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 		if hasAutoDestroyAtChange(d) {
 			autoDestroyAt, err := expandAutoDestroyAt(d)
 			if err != nil {
-				return fmt.Errorf("Error expanding auto destroy during update: %w", err)
+				return diag.Errorf("Error expanding auto destroy during update: %s", err)
 			}
 			options.AutoDestroyAt = autoDestroyAt
 		}
@@ -893,10 +921,10 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 
 		if tagBindings, ok := d.Get("tags").(map[string]interface{}); ok {
-			for key, val := range tagBindings {
+			for key, val := range helpers.MergeDefaultTags(config.DefaultTags, helpers.StringMap(tagBindings)) {
 				options.TagBindings = append(options.TagBindings, &tfe.TagBinding{
 					Key:   key,
-					Value: val.(string),
+					Value: val,
 				})
 			}
 
@@ -905,7 +933,7 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 			if len(options.TagBindings) == 0 && !d.Get("ignore_additional_tags").(bool) {
 				err := config.Client.Workspaces.DeleteAllTagBindings(ctx, id)
 				if err != nil {
-					return fmt.Errorf("Error removing tag bindings from workspace %s: %w", id, err)
+					return diag.Errorf("Error removing tag bindings from workspace %s: %s", id, err)
 				}
 			}
 		}
@@ -968,7 +996,7 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 				_, err := config.Client.Workspaces.RemoveVCSConnectionByID(ctx, id)
 				if err != nil {
 					d.Partial(true)
-					return fmt.Errorf("Error removing VCS repo from workspace %s: %w", id, err)
+					return diag.Errorf("Error removing VCS repo from workspace %s: %s", id, err)
 				}
 			}
 		}
@@ -977,8 +1005,8 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 		_, err := config.Client.Workspaces.UpdateByID(ctx, id, options)
 		if err != nil {
 			d.Partial(true)
-			return fmt.Errorf(
-				"Error updating workspace %s: %w", id, err)
+			return diag.Errorf(
+				"Error updating workspace %s: %s", id, err)
 		}
 	}
 
@@ -994,12 +1022,12 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 				},
 			)
 			if err != nil {
-				return fmt.Errorf("Error assigning SSH key to workspace %s: %w", id, err)
+				return diag.Errorf("Error assigning SSH key to workspace %s: %s", id, err)
 			}
 		} else {
 			_, err := config.Client.Workspaces.UnassignSSHKey(ctx, id)
 			if err != nil {
-				return fmt.Errorf("Error unassigning SSH key from workspace %s: %w", id, err)
+				return diag.Errorf("Error unassigning SSH key from workspace %s: %s", id, err)
 			}
 		}
 	}
@@ -1024,7 +1052,7 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 			log.Printf("[DEBUG] Adding tags to workspace: %s", d.Id())
 			err := config.Client.Workspaces.AddTags(ctx, d.Id(), tfe.WorkspaceAddTagsOptions{Tags: addTags})
 			if err != nil {
-				return fmt.Errorf("Error adding tags to workspace %s: %w", d.Id(), err)
+				return diag.Errorf("Error adding tags to workspace %s: %s", d.Id(), err)
 			}
 		}
 
@@ -1039,7 +1067,7 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 			log.Printf("[DEBUG] Removing tags from workspace: %s", d.Id())
 			err := config.Client.Workspaces.RemoveTags(ctx, d.Id(), tfe.WorkspaceRemoveTagsOptions{Tags: removeTags})
 			if err != nil {
-				return fmt.Errorf("Error removing tags from workspace %s: %w", d.Id(), err)
+				return diag.Errorf("Error removing tags from workspace %s: %s", d.Id(), err)
 			}
 		}
 	}
@@ -1064,7 +1092,7 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 			log.Printf("[DEBUG] Adding remote state consumers to workspace: %s", d.Id())
 			err := config.Client.Workspaces.AddRemoteStateConsumers(ctx, d.Id(), options)
 			if err != nil {
-				return fmt.Errorf("Error adding remote state consumers to workspace %s: %w", d.Id(), err)
+				return diag.Errorf("Error adding remote state consumers to workspace %s: %s", d.Id(), err)
 			}
 		}
 
@@ -1079,16 +1107,18 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 			log.Printf("[DEBUG] Removing remote state consumers from workspace: %s", d.Id())
 			err := config.Client.Workspaces.RemoveRemoteStateConsumers(ctx, d.Id(), options)
 			if err != nil {
-				return fmt.Errorf("Error removing remote state consumers from workspace %s: %w", d.Id(), err)
+				return diag.Errorf("Error removing remote state consumers from workspace %s: %s", d.Id(), err)
 			}
 		}
 	}
 
-	return resourceTFEWorkspaceRead(d, meta)
+	return resourceTFEWorkspaceRead(ctx, d, meta)
 }
 
-func safeWorkspaceDelete(ctx context.Context, config ConfiguredClient, id string) error {
-	return retry.RetryContext(ctx, time.Duration(5)*time.Minute, func() *retry.RetryError {
+// safeWorkspaceDelete safe deletes the workspace, retrying until the timeout
+// while its latest state is still being processed.
+func safeWorkspaceDelete(ctx context.Context, config ConfiguredClient, id string, timeout time.Duration) error {
+	err := retryUntilTimeout(ctx, timeout, func() *retry.RetryError {
 		err := config.Client.Workspaces.SafeDeleteByID(ctx, id)
 		if errors.Is(err, tfe.ErrWorkspaceStillProcessing) {
 			return retry.RetryableError(err)
@@ -1097,9 +1127,13 @@ func safeWorkspaceDelete(ctx context.Context, config ConfiguredClient, id string
 		}
 		return nil
 	})
+	if isTimeoutError(err) {
+		return fmt.Errorf("timed out after %s waiting for the latest state of workspace %s to be processed: %w", timeout, id, err)
+	}
+	return err
 }
 
-func resourceTFEWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEWorkspaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 	id := d.Id()
 
@@ -1110,8 +1144,8 @@ func resourceTFEWorkspaceDelete(d *schema.ResourceData, meta interface{}) error
 		if err == tfe.ErrResourceNotFound {
 			return nil
 		}
-		return fmt.Errorf(
-			"Error reading workspace %s: %w", id, err)
+		return diag.Errorf(
+			"Error reading workspace %s: %s", id, err)
 	}
 
 	forceDelete := d.Get("force_delete").(bool)
@@ -1121,7 +1155,7 @@ func resourceTFEWorkspaceDelete(d *schema.ResourceData, meta interface{}) error
 		if forceDelete {
 			err = config.Client.Workspaces.DeleteByID(ctx, id)
 		} else {
-			return fmt.Errorf(
+			return diag.Errorf(
 				"Error deleting workspace %s: This version of Terraform Enterprise does not support workspace safe-delete. Workspaces must be force deleted by setting force_delete=true", id)
 		}
 	} else if *ws.Permissions.CanForceDelete {
@@ -1130,30 +1164,30 @@ func resourceTFEWorkspaceDelete(d *schema.ResourceData, meta interface{}) error
 		} else {
 			err = errWorkspaceResourceCountCheck(id, ws.ResourceCount)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
-			err = safeWorkspaceDelete(ctx, config, id)
-			return errWorkspaceSafeDeleteWithPermission(id, err)
+			err = safeWorkspaceDelete(ctx, config, id, d.Timeout(schema.TimeoutDelete))
+			return diag.FromErr(errWorkspaceSafeDeleteWithPermission(id, err))
 		}
 	} else {
 		if forceDelete {
-			return fmt.Errorf(
+			return diag.Errorf(
 				"Error deleting workspace %s: missing required permissions to set force delete workspaces in the organization", id)
 		}
 		err = errWorkspaceResourceCountCheck(id, ws.ResourceCount)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
-		err = safeWorkspaceDelete(ctx, config, id)
+		err = safeWorkspaceDelete(ctx, config, id, d.Timeout(schema.TimeoutDelete))
 	}
 
 	if err != nil {
 		if err == tfe.ErrResourceNotFound {
 			return nil
 		}
-		return fmt.Errorf(
-			"Error deleting workspace %s: %w", id, err)
+		return diag.Errorf(
+			"Error deleting workspace %s: %s", id, err)
 	}
 	return nil
 }
@@ -1228,7 +1262,7 @@ func resourceTFEWorkspaceImporter(ctx context.Context, d *schema.ResourceData, m
 			d.Id(),
 		)
 	} else if len(s) == 2 {
-		workspaceID, err := fetchWorkspaceExternalID(s[0]+"/"+s[1], config.Client)
+		workspaceID, err := config.lookupWorkspaceExternalID(ctx, s[0]+"/"+s[1])
 		if err != nil {
 			return nil, fmt.Errorf(
 				"error retrieving workspace with name %s from organization %s %w", s[1], s[0], err)
@@ -16,6 +16,7 @@ import (
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -25,9 +26,9 @@ func resourceTFEWorkspacePolicySet() *schema.Resource {
 			"\n\n~> **Note:** `tfe_policy_set` has an argument `workspace_ids` that should not be used alongside this resource as they manage the same attachments." +
 			"\n\n~> **Note:** Tag-based scoping and explicit workspace/project associations are mutually exclusive on a policy set. To switch between them, first remove the existing association (`terraform apply`), then add the new one (`terraform apply`).",
 
-		Create: resourceTFEWorkspacePolicySetCreate,
-		Read:   resourceTFEWorkspacePolicySetRead,
-		Delete: resourceTFEWorkspacePolicySetDelete,
+		CreateWithoutTimeout: resourceTFEWorkspacePolicySetCreate,
+		ReadWithoutTimeout:   resourceTFEWorkspacePolicySetRead,
+		DeleteWithoutTimeout: resourceTFEWorkspacePolicySetDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFEWorkspacePolicySetImporter,
 		},
@@ -56,7 +57,7 @@ func resourceTFEWorkspacePolicySet() *schema.Resource {
 	}
 }
 
-func resourceTFEWorkspacePolicySetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEWorkspacePolicySetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	policySetID := d.Get("policy_set_id").(string)
@@ -67,16 +68,16 @@ func resourceTFEWorkspacePolicySetCreate(d *schema.ResourceData, meta interface{
 
 	err := config.Client.PolicySets.AddWorkspaces(ctx, policySetID, policySetAddWorkspacesOptions)
 	if err != nil {
-		return fmt.Errorf(
-			"Error attaching policy set id %s to workspace %s: %w", policySetID, workspaceID, err)
+		return diag.Errorf(
+			"Error attaching policy set id %s to workspace %s: %s", policySetID, workspaceID, err)
 	}
 
 	d.SetId(fmt.Sprintf("%s_%s", workspaceID, policySetID))
 
-	return resourceTFEWorkspacePolicySetRead(d, meta)
+	return resourceTFEWorkspacePolicySetRead(ctx, d, meta)
 }
 
-func resourceTFEWorkspacePolicySetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEWorkspacePolicySetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	policySetID := d.Get("policy_set_id").(string)
@@ -90,7 +91,7 @@ func resourceTFEWorkspacePolicySetRead(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading configuration of policy set %s: %w", policySetID, err)
+		return diag.Errorf("Error reading configuration of policy set %s: %s", policySetID, err)
 	}
 
 	isWorkspaceAttached := false
@@ -112,7 +113,7 @@ func resourceTFEWorkspacePolicySetRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceTFEWorkspacePolicySetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEWorkspacePolicySetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	policySetID := d.Get("policy_set_id").(string)
@@ -124,8 +125,8 @@ func resourceTFEWorkspacePolicySetDelete(d *schema.ResourceData, meta interface{
 
 	err := config.Client.PolicySets.RemoveWorkspaces(ctx, policySetID, policySetRemoveWorkspacesOptions)
 	if err != nil {
-		return fmt.Errorf(
-			"Error detaching workspace %s from policy set %s: %w", workspaceID, policySetID, err)
+		return diag.Errorf(
+			"Error detaching workspace %s from policy set %s: %s", workspaceID, policySetID, err)
 	}
 
 	return nil
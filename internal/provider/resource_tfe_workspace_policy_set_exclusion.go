@@ -16,6 +16,7 @@ import (
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -25,9 +26,9 @@ func resourceTFEWorkspacePolicySetExclusion() *schema.Resource {
 			"\n\n~> **Note:** `tfe_policy_set` has an argument `workspace_ids` that should not be used alongside this resource because they manage the same attachments." +
 			"\n\n~> **Note:** Tag-based scoping and explicit workspace/project associations are mutually exclusive on a policy set. To switch between them, first remove the existing association (`terraform apply`), then add the new one (`terraform apply`). Attempting both in a single apply may fail.",
 
-		Create: resourceTFEWorkspacePolicySetExclusionCreate,
-		Read:   resourceTFEWorkspacePolicySetExclusionRead,
-		Delete: resourceTFEWorkspacePolicySetExclusionDelete,
+		CreateWithoutTimeout: resourceTFEWorkspacePolicySetExclusionCreate,
+		ReadWithoutTimeout:   resourceTFEWorkspacePolicySetExclusionRead,
+		DeleteWithoutTimeout: resourceTFEWorkspacePolicySetExclusionDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFEWorkspacePolicySetExclusionImporter,
 		},
@@ -56,7 +57,7 @@ func resourceTFEWorkspacePolicySetExclusion() *schema.Resource {
 	}
 }
 
-func resourceTFEWorkspacePolicySetExclusionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEWorkspacePolicySetExclusionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	policySetID := d.Get("policy_set_id").(string)
@@ -67,16 +68,16 @@ func resourceTFEWorkspacePolicySetExclusionCreate(d *schema.ResourceData, meta i
 
 	err := config.Client.PolicySets.AddWorkspaceExclusions(ctx, policySetID, policySetAddWorkspaceExclusionsOptions)
 	if err != nil {
-		return fmt.Errorf(
-			"error adding workspace exclusion %s to policy set id %s: %w", workspaceExclusionID, policySetID, err)
+		return diag.Errorf(
+			"error adding workspace exclusion %s to policy set id %s: %s", workspaceExclusionID, policySetID, err)
 	}
 
 	d.SetId(fmt.Sprintf("%s_%s", workspaceExclusionID, policySetID))
 
-	return resourceTFEWorkspacePolicySetExclusionRead(d, meta)
+	return resourceTFEWorkspacePolicySetExclusionRead(ctx, d, meta)
 }
 
-func resourceTFEWorkspacePolicySetExclusionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEWorkspacePolicySetExclusionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	policySetID := d.Get("policy_set_id").(string)
@@ -90,7 +91,7 @@ func resourceTFEWorkspacePolicySetExclusionRead(d *schema.ResourceData, meta int
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error reading configuration of policy set %s: %w", policySetID, err)
+		return diag.Errorf("error reading configuration of policy set %s: %s", policySetID, err)
 	}
 
 	isWorkspaceExclusionsAttached := false
@@ -112,7 +113,7 @@ func resourceTFEWorkspacePolicySetExclusionRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceTFEWorkspacePolicySetExclusionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEWorkspacePolicySetExclusionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	policySetID := d.Get("policy_set_id").(string)
@@ -124,8 +125,8 @@ func resourceTFEWorkspacePolicySetExclusionDelete(d *schema.ResourceData, meta i
 
 	err := config.Client.PolicySets.RemoveWorkspaceExclusions(ctx, policySetID, policySetRemoveWorkspaceExclusionsOptions)
 	if err != nil {
-		return fmt.Errorf(
-			"error removing excluded workspace %s from policy set %s: %w", workspaceExclusionsID, policySetID, err)
+		return diag.Errorf(
+			"error removing excluded workspace %s from policy set %s: %s", workspaceExclusionsID, policySetID, err)
 	}
 
 	return nil
@@ -9,12 +9,17 @@
 package provider
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
+	"regexp"
+	"time"
 
 	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var (
@@ -70,18 +75,48 @@ var policyOverridePendingStatuses = map[tfe.RunStatus]bool{
 	tfe.RunPolicyOverride: true,
 }
 
+var postPlanOverriddenStatuses = map[tfe.RunStatus]bool{
+	tfe.RunPlannedAndFinished: true,
+	tfe.RunConfirmed:          true,
+	tfe.RunApplyQueued:        true,
+	tfe.RunApplying:           true,
+}
+
+// postPlanOverridePendingStatuses are the statuses a run can pass through
+// after its post-plan stage is overridden, until it can be confirmed.
+var postPlanOverridePendingStatuses = map[tfe.RunStatus]bool{
+	tfe.RunPostPlanAwaitingDecision: true,
+	tfe.RunPostPlanRunning:          true,
+	tfe.RunPostPlanCompleted:        true,
+	tfe.RunCostEstimating:           true,
+	tfe.RunCostEstimated:            true,
+	tfe.RunPolicyChecking:           true,
+	tfe.RunPolicyChecked:            true,
+	tfe.RunPlanned:                  true,
+}
+
 func resourceTFEWorkspaceRun() *schema.Resource {
 	return &schema.Resource{
 		Description: "Provides a resource to manage the _initial_ and/or _final_ Terraform run in a given workspace. These initial and final runs often have a special relationship to other things that depend on the workspace's existence, so it can be useful to manage the completion of these runs in the same Terraform configuration that manages the workspace." +
 			"\n\n~> **Note:** Use caution when removing `tfe_workspace_run` from configuration. Destroying with a `destroy` block present creates a destroy run for underlying managed resources." +
 			"\n\nThere are a few main use cases this resource was designed for: \n - **Workspaces that depend on other workspaces.** If a workspace will create infrastructure that other workspaces rely on (for example, a Kubernetes cluster to deploy resources into), those downstream workspaces can depend on an initial `apply` with `wait_for_run = true`, so they aren't created before their infrastructure dependencies.\n- **A more reliable `queue_all_runs = true`.** The `queue_all_runs` argument on `tfe_workspace` requests an initial run, which can complete asynchronously outside of the Terraform run that creates the workspace. Unfortunately, it can't be used with workspaces that require variables to be set, because the `tfe_variable` resources themselves depend on the `tfe_workspace`. By managing an initial `apply` with `wait_for_run = false` that depends on your `tfe_variables`, you can accomplish the same goal without a circular dependency.\n- **Safe workspace destruction.** To ensure a workspace's managed resources are destroyed before deleting it, add a `destroy` block with `wait_for_run = true`. When you destroy the `tfe_workspace_run` resource, Terraform will wait for the destroy run to complete before deleting the workspace. This pattern is compatible with the `tfe_workspace` resource's default safe deletion behavior.\nThe `tfe_workspace_run` expects to own exactly one apply during a creation and/or one destroy during a destruction. This implies that even if previous successful applies exist in the workspace, a `tfe_workspace_run` resource that includes an `apply` block will queue a new apply when added to a config." +
 			"\n\n-> **Note:** Using `manual_confirm` will override the workspace's default apply mode. To use the workspace default apply mode, look up the setting for `auto_apply` with the `tfe_workspace` data source." +
-			"\n\n~> **Note:** If a `destroy` run cannot be created because the workspace has no configuration version (for example, an empty workspace that never had a configuration uploaded), the destroy is automatically treated as a no-op success. This follows the standard Terraform convention of treating the destruction of an already-absent resource as a success.",
+			"\n\n~> **Note:** If a `destroy` run cannot be created because the workspace has no configuration version (for example, an empty workspace that never had a configuration uploaded), the destroy is automatically treated as a no-op success. This follows the standard Terraform convention of treating the destruction of an already-absent resource as a success." +
+			"\n\n-> **Note:** When a run errors during its plan or its apply, the error links to the run and includes the last error lines of the log of the failed phase. The lines of structured run output are reduced to their error messages.",
+
+		CreateContext: resourceTFEWorkspaceRunCreate,
+		DeleteContext: resourceTFEWorkspaceRunDelete,
+		ReadContext:   resourceTFEWorkspaceRunRead,
+		UpdateContext: resourceTFEWorkspaceRunUpdate,
+		CustomizeDiff: customizeDiffWorkspaceRunOptions,
+
+		// Runs can wait in the queue or for confirmation for a long time, so
+		// the runs are only abandoned after a day by default.
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(24 * time.Hour),
+			Delete: schema.DefaultTimeout(24 * time.Hour),
+		},
 
-		Create:        resourceTFEWorkspaceRunCreate,
-		Delete:        resourceTFEWorkspaceRunDelete,
-		Read:          resourceTFEWorkspaceRunRead,
-		Update:        resourceTFEWorkspaceRunUpdate,
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -110,30 +145,94 @@ func resourceTFEWorkspaceRun() *schema.Resource {
 				Optional:    true,
 				MaxItems:    1,
 			},
+			"status": {
+				Description: "The status of the apply run, such as `applied` or `planned_and_finished`. The results of destroy runs are only logged, since the resource is removed from state once its destroy run completes.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"has_changes": {
+				Description: "Whether the plan of the apply run has changes.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"resource_additions": {
+				Description: "The number of resources added by the apply run, or planned to be added when the run was not applied.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"resource_changes": {
+				Description: "The number of resources changed by the apply run, or planned to be changed when the run was not applied.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"resource_destructions": {
+				Description: "The number of resources destroyed by the apply run, or planned to be destroyed when the run was not applied.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"resource_imports": {
+				Description: "The number of resources imported by the apply run, or planned to be imported when the run was not applied.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"policy_check_status": {
+				Description: "The status of the last policy check of the apply run, such as `passed` or `soft_failed`. Empty when no policy check ran.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"delta_monthly_cost": {
+				Description: "The change in estimated monthly cost of the apply run, in USD. Empty when no cost estimate ran.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"html_url": {
+				Description: "The URL to the browsable HTML overview of the apply run.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 		},
 	}
 }
 
-func resourceTFEWorkspaceRunCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEWorkspaceRunCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// var isDestroyRun & currentRetryAttempts is declared for the sole purpose of code readability
 	isDestroyRun := false
 	currentRetryAttempts := 0
-	return createWorkspaceRun(d, meta, isDestroyRun, currentRetryAttempts)
+	if err := createWorkspaceRun(ctx, d, meta, isDestroyRun, currentRetryAttempts); err != nil {
+		return workspaceRunDiagnostics(err)
+	}
+
+	return resourceTFEWorkspaceRunRead(ctx, d, meta)
 }
 
-func resourceTFEWorkspaceRunDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEWorkspaceRunDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// var isDestroyRun & currentRetryAttempts is declared for the sole purpose of code readability
 	isDestroyRun := true
 	currentRetryAttempts := 0
-	return createWorkspaceRun(d, meta, isDestroyRun, currentRetryAttempts)
+	return workspaceRunDiagnostics(createWorkspaceRun(ctx, d, meta, isDestroyRun, currentRetryAttempts))
 }
 
-func resourceTFEWorkspaceRunUpdate(d *schema.ResourceData, meta interface{}) error {
+// workspaceRunDiagnostics converts the error of a run into diagnostics, with
+// the excerpt of the log of the failed phase of errored runs as their detail.
+func workspaceRunDiagnostics(err error) diag.Diagnostics {
+	var runErr *runErroredError
+	if !errors.As(err, &runErr) {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  runErr.Error(),
+		Detail:   runErr.detail(),
+	}}
+}
+
+func resourceTFEWorkspaceRunUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// update is a noop since this resource only creates a run during a destroy or an initial apply phase
 	return nil
 }
 
-func resourceTFEWorkspaceRunRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEWorkspaceRunRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// First check whether this is a destroy-only run
 	_, ok := d.GetOk("apply")
 	if !ok {
@@ -148,7 +247,7 @@ func resourceTFEWorkspaceRunRead(d *schema.ResourceData, meta interface{}) error
 
 	log.Printf("[DEBUG] Read run for: %s", d.Id())
 	runID := d.Id()
-	_, err := config.Client.Runs.Read(ctx, runID)
+	run, err := readRunWithResult(ctx, config.Client, runID)
 	if err != nil {
 		if errors.Is(err, tfe.ErrResourceNotFound) {
 			// It would be very strange for this to happen, since runs can't
@@ -159,9 +258,60 @@ func resourceTFEWorkspaceRunRead(d *schema.ResourceData, meta interface{}) error
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error reading run %s: %w", d.Id(), err)
+		return diag.Errorf("error reading run %s: %s", d.Id(), err)
+	}
+
+	return setWorkspaceRunResult(ctx, config, d, run)
+}
+
+// customizeDiffWorkspaceRunOptions fails the plan when the options of the
+// apply or destroy run cannot be combined.
+func customizeDiffWorkspaceRunOptions(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	for _, block := range []string{"apply", "destroy"} {
+		v, ok := diff.GetOk(block)
+		if !ok {
+			continue
+		}
+		runArgs, ok := v.([]interface{})[0].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		refreshOnly := runArgs["refresh_only"].(bool)
+		hasReplaceAddrs := len(runArgs["replace_addrs"].([]interface{})) > 0
+
+		switch {
+		case block == "destroy" && refreshOnly:
+			return fmt.Errorf("refresh_only is not supported in the destroy block, destroy runs cannot be refresh-only")
+		case block == "destroy" && hasReplaceAddrs:
+			return fmt.Errorf("replace_addrs is not supported in the destroy block, destroy runs cannot replace resources")
+		case refreshOnly && hasReplaceAddrs:
+			return fmt.Errorf("refresh_only cannot be combined with replace_addrs in the %s block", block)
+		}
+	}
+
+	return nil
+}
+
+// setWorkspaceRunResult sets the computed attributes describing the outcome
+// of the apply run.
+func setWorkspaceRunResult(ctx context.Context, config ConfiguredClient, d *schema.ResourceData, run *tfe.Run) diag.Diagnostics {
+	policyCheckStatus, err := readRunPolicyCheckStatus(ctx, config.Client, run)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
+	result := newRunResult(run)
+	d.Set("status", string(run.Status))
+	d.Set("has_changes", run.HasChanges)
+	d.Set("resource_additions", result.additions)
+	d.Set("resource_changes", result.changes)
+	d.Set("resource_destructions", result.destructions)
+	d.Set("resource_imports", result.imports)
+	d.Set("policy_check_status", policyCheckStatus)
+	d.Set("delta_monthly_cost", result.deltaMonthlyCost)
+	d.Set("html_url", runHTMLURL(config.Client, run))
+
 	return nil
 }
 
@@ -202,6 +352,62 @@ func resourceTFEWorkspaceRunSchema() *schema.Resource {
 				Optional:    true,
 				Default:     30,
 			},
+			"target_addrs": {
+				Description: "A list of resource addresses to target. The run only plans the changes of these resources and of the resources they depend on, like the `-target` option of the Terraform CLI.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+			"replace_addrs": {
+				Description: "A list of resource addresses to replace, like the `-replace` option of the Terraform CLI. Not supported for destroy runs or together with `refresh_only`.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+			"refresh_only": {
+				Description: "Whether the run only refreshes the state, ignoring the changes of the configuration, like the `-refresh-only` option of the Terraform CLI. Not supported for destroy runs. Defaults to `false`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"allow_empty_apply": {
+				Description: "Whether the run is applied even when its plan has no changes, for example to upgrade the state to a new Terraform version. Defaults to `false`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"variables": {
+				Description: "Terraform input variables of the run, which take precedence over the variables of the workspace. The values are HCL literals, so strings must be quoted, such as `\"\\\"us-east-1\\\"\"`.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				ValidateDiagFunc: validation.MapKeyMatch(
+					regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`),
+					"must be a valid Terraform variable name",
+				),
+			},
+			"policy_override": {
+				Description: "Adding a policy_override block makes the provider override soft-failed Sentinel policy checks, failed OPA policy evaluations and failed run tasks of the run instead of waiting for a manual override. The token needs permission to override policies and run tasks, otherwise the run fails before anything is overridden.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"comment": {
+							Description:  "The justification of the override, recorded in a run comment along with the user of the token once the override succeeds.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+					},
+				},
+			},
 			"wait_for_run": {
 				Description: "Whether or not to wait for a run to reach completion before considering this a success. When set to `false`, the provider considers the `tfe_workspace_run` resource to have been created immediately after the run has been queued. When set to `true`, the provider waits for a successful apply on the target workspace (or a no-change plan). Defaults to `true`.",
 				Type:        schema.TypeBool,
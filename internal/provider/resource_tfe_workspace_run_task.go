@@ -69,7 +69,7 @@ func NewWorkspaceRunTaskResource() resource.Resource {
 	return &resourceWorkspaceRunTask{}
 }
 
-func modelFromTFEWorkspaceRunTaskV2(v models.WorkspaceTasksable) modelTFEWorkspaceRunTaskV1 {
+func modelFromTFEWorkspaceRunTaskV2(ctx context.Context, v models.WorkspaceTasksable) modelTFEWorkspaceRunTaskV1 {
 	result := modelTFEWorkspaceRunTaskV1{
 		ID:               types.StringValue(""),
 		WorkspaceID:      types.StringValue(""),
@@ -215,7 +215,7 @@ func (r *resourceWorkspaceRunTask) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	result := modelFromTFEWorkspaceRunTaskV2(wstask.GetData())
+	result := modelFromTFEWorkspaceRunTaskV2(ctx, wstask.GetData())
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
@@ -247,7 +247,7 @@ func (r *resourceWorkspaceRunTask) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
-	stage, stages := r.extractStageAndStages(plan, &resp.Diagnostics)
+	stage, stages := r.extractStageAndStages(ctx, plan, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -269,7 +269,7 @@ func (r *resourceWorkspaceRunTask) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
-	result := modelFromTFEWorkspaceRunTaskV2(wstask.GetData())
+	result := modelFromTFEWorkspaceRunTaskV2(ctx, wstask.GetData())
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
@@ -284,7 +284,7 @@ func (r *resourceWorkspaceRunTask) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
-	stage, stages := r.extractStageAndStages(plan, &resp.Diagnostics)
+	stage, stages := r.extractStageAndStages(ctx, plan, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -310,7 +310,7 @@ func (r *resourceWorkspaceRunTask) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
-	result := modelFromTFEWorkspaceRunTaskV2(wstask.GetData())
+	result := modelFromTFEWorkspaceRunTaskV2(ctx, wstask.GetData())
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
@@ -353,7 +353,7 @@ func (r *resourceWorkspaceRunTask) ImportState(ctx context.Context, req resource
 	workspaceName := s[1]
 	orgName := s[0]
 
-	if wstask, err := fetchWorkspaceRunTaskV2(taskName, workspaceName, orgName, r.config.ClientV2); err != nil {
+	if wstask, err := fetchWorkspaceRunTaskV2(ctx, taskName, workspaceName, orgName, r.config.ClientV2); err != nil {
 		resp.Diagnostics.AddError(
 			"Error importing workspace run task",
 			err.Error(),
@@ -364,7 +364,7 @@ func (r *resourceWorkspaceRunTask) ImportState(ctx context.Context, req resource
 			"Workspace task does not exist or has no details",
 		)
 	} else {
-		result := modelFromTFEWorkspaceRunTaskV2(wstask)
+		result := modelFromTFEWorkspaceRunTaskV2(ctx, wstask)
 		resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
 	}
 }
@@ -400,7 +400,7 @@ func (r *resourceWorkspaceRunTask) UpgradeState(ctx context.Context) map[int64]r
 					return
 				}
 
-				newData := modelFromTFEWorkspaceRunTaskV2(wstask.GetData())
+				newData := modelFromTFEWorkspaceRunTaskV2(ctx, wstask.GetData())
 				diags = resp.State.Set(ctx, newData)
 				resp.Diagnostics.Append(diags...)
 			},
@@ -418,7 +418,7 @@ func (r *resourceWorkspaceRunTask) addStageSupportDiag(d *diag.Diagnostics, isEr
 	}
 }
 
-func (r *resourceWorkspaceRunTask) extractStageAndStages(plan modelTFEWorkspaceRunTaskV1, d *diag.Diagnostics) (*string, []string) {
+func (r *resourceWorkspaceRunTask) extractStageAndStages(ctx context.Context, plan modelTFEWorkspaceRunTaskV1, d *diag.Diagnostics) (*string, []string) {
 	// There are some complex interactions here between deprecated values in the TF model, and whether the backend server even supports the newer
 	// API call style. This function attempts to extract the Stage and Stages properties and emit useful diagnostics
 
@@ -570,13 +570,13 @@ func newWorkspaceRunTaskUpdateEnvelope(id, enforcementLevel string, stage *strin
 	return envelope, nil
 }
 
-func fetchWorkspaceRunTaskV2(name, workspace, organization string, client *tfe.Client) (models.WorkspaceTasksable, error) {
-	task, err := fetchOrganizationRunTaskV2(name, organization, client)
+func fetchWorkspaceRunTaskV2(ctx context.Context, name, workspace, organization string, client *tfe.Client) (models.WorkspaceTasksable, error) {
+	task, err := fetchOrganizationRunTaskV2(ctx, name, organization, client)
 	if err != nil {
 		return nil, fmt.Errorf("Error reading configuration of task %s in organization %s: %w", name, organization, err)
 	}
 
-	ws, err := fetchWorkspaceV2(workspace, organization, client)
+	ws, err := fetchWorkspaceV2(ctx, workspace, organization, client)
 	if err != nil {
 		return nil, fmt.Errorf("Error reading configuration of workspace %s in organization %s: %w", workspace, organization, err)
 	}
@@ -631,7 +631,7 @@ func fetchWorkspaceRunTaskV2(name, workspace, organization string, client *tfe.C
 	return nil, fmt.Errorf("could not find organization run task %s for workspace %s in organization %s", name, workspace, organization)
 }
 
-func fetchOrganizationRunTaskV2(name, organization string, client *tfe.Client) (models.Tasksable, error) {
+func fetchOrganizationRunTaskV2(ctx context.Context, name, organization string, client *tfe.Client) (models.Tasksable, error) {
 	pageNumber := int32(1)
 	for {
 		query := &organizationsapi.ItemTasksRequestBuilderGetQueryParameters{
@@ -671,7 +671,7 @@ func fetchOrganizationRunTaskV2(name, organization string, client *tfe.Client) (
 	return nil, fmt.Errorf("could not find organization run task for organization %s and name %s", organization, name)
 }
 
-func fetchWorkspaceV2(workspace, organization string, client *tfe.Client) (models.Workspacesable, error) {
+func fetchWorkspaceV2(ctx context.Context, workspace, organization string, client *tfe.Client) (models.Workspacesable, error) {
 	ws, err := client.API.Organizations().ByOrganization_name(organization).Workspaces().ByWorkspace_name(workspace).Get(ctx, nil)
 	if err != nil {
 		return nil, err
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/rand"
 	"regexp"
+	"strings"
 	"testing"
 	"time"
 
@@ -59,6 +60,15 @@ func TestAccTFEWorkspaceRun_withApplyOnlyBlock(t *testing.T) {
 						}
 						return nil
 					}),
+					resource.TestCheckResourceAttr("tfe_workspace_run.ws_run_parent", "status", string(tfe.RunApplied)),
+					resource.TestCheckResourceAttr("tfe_workspace_run.ws_run_parent", "has_changes", "true"),
+					resource.TestCheckResourceAttrSet("tfe_workspace_run.ws_run_parent", "resource_additions"),
+					resource.TestCheckResourceAttrWith("tfe_workspace_run.ws_run_parent", "html_url", func(value string) error {
+						if !strings.HasSuffix(value, "/runs/"+runForParentWorkspace.ID) {
+							return fmt.Errorf("html_url for ws_run_parent should link to run %s but was %s", runForParentWorkspace.ID, value)
+						}
+						return nil
+					}),
 				),
 			},
 		},
@@ -184,6 +194,18 @@ func TestAccTFEWorkspaceRun_invalidParams(t *testing.T) {
 			Config:      testAccTFEWorkspaceRun_noWorkspaceProvided(),
 			ExpectError: regexp.MustCompile(`The argument "workspace_id" is required, but no definition was found`),
 		},
+		{
+			Config:      testAccTFEWorkspaceRun_refreshOnlyDestroy(organization.Name, rInt),
+			ExpectError: regexp.MustCompile(`refresh_only is not supported in the destroy block`),
+		},
+		{
+			Config:      testAccTFEWorkspaceRun_replaceAddrsDestroy(organization.Name, rInt),
+			ExpectError: regexp.MustCompile(`replace_addrs is not supported in the destroy block`),
+		},
+		{
+			Config:      testAccTFEWorkspaceRun_refreshOnlyWithReplaceAddrs(organization.Name, rInt),
+			ExpectError: regexp.MustCompile(`refresh_only cannot be combined with replace_addrs in the apply block`),
+		},
 	}
 
 	for _, invalidCase := range invalidCases {
@@ -223,7 +245,7 @@ func TestAccTFEWorkspaceRun_WhenRunErrors(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccTFEWorkspaceRun_WhenRunErrors(parentWorkspace.ID),
-				ExpectError: regexp.MustCompile(`run errored during plan`),
+				ExpectError: regexp.MustCompile(`(?s)run errored during plan, view the run run-\S+ on \S+ for details.*Last error lines of the plan log.*Error: `),
 			},
 		},
 	})
@@ -426,6 +448,61 @@ func testAccTFEWorkspaceRun_noWorkspaceProvided() string {
 `
 }
 
+func testAccTFEWorkspaceRun_refreshOnlyDestroy(orgName string, rInt int) string {
+	return fmt.Sprintf(`
+	resource "tfe_workspace" "parent" {
+		name                 = "tst-terraform-%d-parent"
+		organization         = "%s"
+	}
+
+	resource "tfe_workspace_run" "ws_run_parent" {
+		workspace_id    = tfe_workspace.parent.id
+
+		destroy {
+			manual_confirm = false
+			refresh_only   = true
+		}
+	}
+`, rInt, orgName)
+}
+
+func testAccTFEWorkspaceRun_replaceAddrsDestroy(orgName string, rInt int) string {
+	return fmt.Sprintf(`
+	resource "tfe_workspace" "parent" {
+		name                 = "tst-terraform-%d-parent"
+		organization         = "%s"
+	}
+
+	resource "tfe_workspace_run" "ws_run_parent" {
+		workspace_id    = tfe_workspace.parent.id
+
+		destroy {
+			manual_confirm = false
+			replace_addrs  = ["random_pet.always_new"]
+		}
+	}
+`, rInt, orgName)
+}
+
+func testAccTFEWorkspaceRun_refreshOnlyWithReplaceAddrs(orgName string, rInt int) string {
+	return fmt.Sprintf(`
+	resource "tfe_workspace" "parent" {
+		name                 = "tst-terraform-%d-parent"
+		organization         = "%s"
+	}
+
+	resource "tfe_workspace_run" "ws_run_parent" {
+		workspace_id    = tfe_workspace.parent.id
+
+		apply {
+			manual_confirm = false
+			refresh_only   = true
+			replace_addrs  = ["random_pet.always_new"]
+		}
+	}
+`, rInt, orgName)
+}
+
 func testAccTFEWorkspaceRun_WhenRunErrors(workspaceID string) string {
 	return fmt.Sprintf(`
 	resource "tfe_workspace_run" "ws_run_parent" {
@@ -25,12 +25,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-tfe/internal/provider/helpers"
 )
 
 // tfe_workspace_settings resource
 var (
 	_ resource.Resource                = &workspaceSettings{}
 	_ resource.ResourceWithImportState = &workspaceSettings{}
+	_ resource.ResourceWithModifyPlan  = &workspaceSettings{}
 )
 
 // overwritesElementType is the object type definition for the
@@ -61,6 +63,7 @@ type modelWorkspaceSettings struct {
 	AssessmentsEnabled     types.Bool   `tfsdk:"assessments_enabled"`
 	Tags                   types.Map    `tfsdk:"tags"`
 	EffectiveTags          types.Map    `tfsdk:"effective_tags"`
+	TagsAll                types.Map    `tfsdk:"tags_all"`
 }
 
 type modelOverwrites struct {
@@ -183,7 +186,7 @@ func (m validateAgentExecutionMode) MarkdownDescription(_ context.Context) strin
 	return "Validates that configuration values for \"agent_pool_id\" and \"execution_mode\" are compatible"
 }
 
-func (m validateRemoteStateConsumerIDs) PlanModifySet(_ context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+func (m validateRemoteStateConsumerIDs) PlanModifySet(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
 	var remoteStateConsumerIDs types.Set
 	diags := req.Config.GetAttribute(ctx, path.Root("remote_state_consumer_ids"), &remoteStateConsumerIDs)
 	if diags.HasError() {
@@ -226,7 +229,7 @@ func (m validateRemoteStateConsumerIDs) MarkdownDescription(_ context.Context) s
 	return "Validates that configuration values for \"global_remote_state\", \"project_remote_state\" and \"remote_state_consumer_ids\" are compatible"
 }
 
-func (m validateSelfReference) PlanModifySet(_ context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+func (m validateSelfReference) PlanModifySet(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
 	var remoteStateConsumerIDSet types.Set
 	diags := req.Plan.GetAttribute(ctx, path.Root("remote_state_consumer_ids"), &remoteStateConsumerIDSet)
 	if diags.HasError() {
@@ -481,12 +484,18 @@ func (r *workspaceSettings) Schema(ctx context.Context, req resource.SchemaReque
 				Computed:    true,
 				ElementType: types.StringType,
 			},
+
+			"tags_all": schema.MapAttribute{
+				Description: "A map of key-value tags set on the workspace, including the provider `default_tags`.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
 		},
 	}
 }
 
 // workspaceSettingsModelFromTFEWorkspace builds a resource model from the TFE model
-func (r *workspaceSettings) workspaceSettingsModelFromTFEWorkspace(ws *tfe.Workspace, effectiveTagBindings []models.EffectiveTagBindingsable) *modelWorkspaceSettings {
+func (r *workspaceSettings) workspaceSettingsModelFromTFEWorkspace(ctx context.Context, ws *tfe.Workspace, effectiveTagBindings []models.EffectiveTagBindingsable) *modelWorkspaceSettings {
 	result := modelWorkspaceSettings{
 		ID:                 types.StringValue(ws.ID),
 		WorkspaceID:        types.StringValue(ws.ID),
@@ -508,7 +517,7 @@ func (r *workspaceSettings) workspaceSettingsModelFromTFEWorkspace(ws *tfe.Works
 	result.RemoteStateConsumerIDs = types.SetValueMust(types.StringType, []attr.Value{})
 
 	if !ws.GlobalRemoteState && !ws.ProjectRemoteState {
-		_, remoteStateConsumerIDs, err := readWorkspaceStateConsumers(ws.ID, r.config.Client)
+		_, remoteStateConsumerIDs, err := readWorkspaceStateConsumers(ctx, ws.ID, r.config.Client)
 		if err != nil {
 			log.Printf("[ERROR] Error reading remote state consumers for workspace %s: %s", ws.ID, err)
 			return nil
@@ -556,11 +565,27 @@ func (r *workspaceSettings) workspaceSettingsModelFromTFEWorkspace(ws *tfe.Works
 		effectiveTagElems[key] = types.StringValue(val)
 	}
 	result.Tags = types.MapValueMust(types.StringType, tagElems)
+	result.TagsAll = types.MapValueMust(types.StringType, tagElems)
 	result.EffectiveTags = types.MapValueMust(types.StringType, effectiveTagElems)
 
 	return &result
 }
 
+// removeDefaultTags leaves the provider default tags out of the tags of the
+// model, unless they are part of the configured tags. They remain in tags_all.
+func (r *workspaceSettings) removeDefaultTags(model *modelWorkspaceSettings, configured types.Map) {
+	if model == nil || len(r.config.DefaultTags) == 0 {
+		return
+	}
+
+	tags := helpers.RemoveDefaultTags(r.config.DefaultTags, planTagBindings(model.TagsAll), planTagBindings(configured))
+	tagElems := make(map[string]attr.Value, len(tags))
+	for key, value := range tags {
+		tagElems[key] = types.StringValue(value)
+	}
+	model.Tags = types.MapValueMust(types.StringType, tagElems)
+}
+
 func (r *workspaceSettings) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data modelWorkspaceSettings
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -572,6 +597,7 @@ func (r *workspaceSettings) Read(ctx context.Context, req resource.ReadRequest,
 	} else if err != nil {
 		resp.Diagnostics.AddError("Error reading workspace", err.Error())
 	}
+	r.removeDefaultTags(model, data.Tags)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
 }
@@ -595,7 +621,7 @@ func (r *workspaceSettings) readSettings(ctx context.Context, workspaceID string
 		effectiveTagBindings = etbResp.GetData()
 	}
 
-	return r.workspaceSettingsModelFromTFEWorkspace(ws, effectiveTagBindings), nil
+	return r.workspaceSettingsModelFromTFEWorkspace(ctx, ws, effectiveTagBindings), nil
 }
 
 func (r *workspaceSettings) updateSettings(ctx context.Context, data *modelWorkspaceSettings, priorState, targetState *tfsdk.State) error {
@@ -643,8 +669,10 @@ func (r *workspaceSettings) updateSettings(ctx context.Context, data *modelWorks
 		updateOptions.ExecutionMode = tfe.String("remote")
 	}
 
+	// The provider default tags are only added when this resource manages the
+	// tags of the workspace.
 	if !data.Tags.IsNull() && !data.Tags.IsUnknown() {
-		tags := data.Tags.Elements()
+		tags := helpers.MergeDefaultTags(r.config.DefaultTags, planTagBindings(data.Tags))
 
 		switch {
 		case len(tags) == 0:
@@ -653,13 +681,10 @@ func (r *workspaceSettings) updateSettings(ctx context.Context, data *modelWorks
 			}
 		default:
 			for key, val := range tags {
-				strVal, ok := val.(types.String)
-				if ok && !strVal.IsNull() && !strVal.IsUnknown() {
-					updateOptions.TagBindings = append(updateOptions.TagBindings, &tfe.TagBinding{
-						Key:   key,
-						Value: strVal.ValueString(),
-					})
-				}
+				updateOptions.TagBindings = append(updateOptions.TagBindings, &tfe.TagBinding{
+					Key:   key,
+					Value: val,
+				})
 			}
 		}
 	}
@@ -670,7 +695,7 @@ func (r *workspaceSettings) updateSettings(ctx context.Context, data *modelWorks
 	}
 
 	if !data.GlobalRemoteState.ValueBool() && !data.ProjectRemoteState.ValueBool() {
-		if err := r.addAndRemoveRemoteStateConsumers(workspaceID, data.RemoteStateConsumerIDs, priorState); err != nil {
+		if err := r.addAndRemoveRemoteStateConsumers(ctx, workspaceID, data.RemoteStateConsumerIDs, priorState); err != nil {
 			return err
 		}
 	}
@@ -679,6 +704,7 @@ func (r *workspaceSettings) updateSettings(ctx context.Context, data *modelWorks
 	if errors.Is(err, errWorkspaceNoLongerExists) {
 		targetState.RemoveResource(ctx)
 	}
+	r.removeDefaultTags(model, data.Tags)
 
 	if err == nil {
 		targetState.Set(ctx, model)
@@ -698,11 +724,11 @@ func shouldSendExecutionModeOverwrite(ctx context.Context, overwrites types.List
 	return len(overwritesPlanned) > 0 && overwritesPlanned[0].ExecutionMode.ValueBool()
 }
 
-func (r *workspaceSettings) oldRemoteStateConsumerIDs(workspaceID string, state *tfsdk.State) ([]string, error) {
+func (r *workspaceSettings) oldRemoteStateConsumerIDs(ctx context.Context, workspaceID string, state *tfsdk.State) ([]string, error) {
 	if state == nil || state.Raw.IsNull() {
 		// No prior state (e.g. during Create after import). Read current
 		// consumers from the API so we can remove any that aren't desired.
-		_, currentIDs, err := readWorkspaceStateConsumers(workspaceID, r.config.Client)
+		_, currentIDs, err := readWorkspaceStateConsumers(ctx, workspaceID, r.config.Client)
 		if err != nil {
 			return nil, fmt.Errorf("error reading current remote state consumers for workspace %s: %w", workspaceID, err)
 		}
@@ -727,8 +753,8 @@ func (r *workspaceSettings) oldRemoteStateConsumerIDs(workspaceID string, state
 	return oldWorkspaceIDs, nil
 }
 
-func (r *workspaceSettings) addAndRemoveRemoteStateConsumers(workspaceID string, newWorkspaceIDsSet types.Set, state *tfsdk.State) error {
-	oldWorkspaceIDs, err := r.oldRemoteStateConsumerIDs(workspaceID, state)
+func (r *workspaceSettings) addAndRemoveRemoteStateConsumers(ctx context.Context, workspaceID string, newWorkspaceIDsSet types.Set, state *tfsdk.State) error {
+	oldWorkspaceIDs, err := r.oldRemoteStateConsumerIDs(ctx, workspaceID, state)
 	if err != nil {
 		return err
 	}
@@ -874,6 +900,22 @@ func (r *workspaceSettings) Delete(ctx context.Context, req resource.DeleteReque
 	}
 }
 
+func (r *workspaceSettings) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// Like the tags themselves, the provider default tags are only managed
+	// when tags are configured.
+	var tags types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("tags"), &tags)...)
+	if resp.Diagnostics.HasError() || tags.IsNull() {
+		return
+	}
+
+	modifyPlanForDefaultTags(ctx, r.config.DefaultTags, req.Config, req.Plan, resp)
+}
+
 func (r *workspaceSettings) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = "tfe_workspace_settings"
 }
@@ -902,7 +944,7 @@ func (r *workspaceSettings) ImportState(ctx context.Context, req resource.Import
 			req.ID,
 		))
 	} else if len(s) == 2 {
-		workspaceID, err := fetchWorkspaceExternalID(s[0]+"/"+s[1], r.config.Client)
+		workspaceID, err := r.config.lookupWorkspaceExternalID(ctx, s[0]+"/"+s[1])
 		if err != nil {
 			resp.Diagnostics.AddError("Error importing workspace settings", fmt.Sprintf(
 				"error retrieving workspace with name %s from organization %s: %s", s[1], s[0], err.Error(),
@@ -619,7 +619,7 @@ func testAccCheckTFEWorkspaceSettingsHasRemoteConsumers(ws string, expectedConsu
 			return err
 		}
 
-		_, actualConsumerIDs, err := readWorkspaceStateConsumers(rsWorkspaceSettings.Primary.ID, tfeClient)
+		_, actualConsumerIDs, err := readWorkspaceStateConsumers(ctx, rsWorkspaceSettings.Primary.ID, tfeClient)
 		if err != nil {
 			return fmt.Errorf("error reading remote state consumers for workspace %s: %w", rsWorkspaceSettings.Primary.ID, err)
 		}
@@ -2266,20 +2266,20 @@ func TestTFEWorkspace_delete_withoutCanForceDeletePermission(t *testing.T) {
 		t.Fatalf("unexpected err creating configuration state %v", err)
 	}
 
-	err = resourceTFEWorkspaceDelete(rd, config)
-	if err == nil {
+	diags := resourceTFEWorkspaceDelete(ctx, rd, config)
+	if !diags.HasError() {
 		t.Fatalf("Expected an error deleting workspace with CanForceDelete=nil, force_delete=false, and %v resources", workspace.ResourceCount)
 	}
 
 	workspace.ResourceCount = 0
 
-	err = resourceTFEWorkspaceDelete(rd, config)
-	if err == nil {
+	diags = resourceTFEWorkspaceDelete(ctx, rd, config)
+	if !diags.HasError() {
 		t.Fatalf("Expected an error deleting workspace with CanForceDelete=nil and force_delete=false")
 	}
 	expectedErrSubstring := "This version of Terraform Enterprise does not support workspace safe-delete. Workspaces must be force deleted by setting force_delete=true"
-	if !strings.Contains(err.Error(), expectedErrSubstring) {
-		t.Fatalf("Expected error contains %s but got %s", expectedErrSubstring, err.Error())
+	if !strings.Contains(diags[0].Summary, expectedErrSubstring) {
+		t.Fatalf("Expected error contains %s but got %s", expectedErrSubstring, diags[0].Summary)
 	}
 
 	// now attempt with force_delete=true and confirm that it successfully removes the workspace
@@ -2288,9 +2288,9 @@ func TestTFEWorkspace_delete_withoutCanForceDeletePermission(t *testing.T) {
 		t.Fatalf("Unexpected err creating configuration state %v", err)
 	}
 
-	err = resourceTFEWorkspaceDelete(rd, config)
-	if err != nil {
-		t.Fatalf("Unexpected err deleting mock workspace %v", err)
+	diags = resourceTFEWorkspaceDelete(ctx, rd, config)
+	if diags.HasError() {
+		t.Fatalf("Unexpected err deleting mock workspace %v", diags)
 	}
 
 	workspace, err = client.Workspaces.ReadByID(ctx, workspace.ID)
@@ -2364,9 +2364,8 @@ func testAccCheckTFEWorkspacePanic(n string) resource.TestCheckFunc {
 		rd := &schema.ResourceData{}
 		rd.SetId(rs.Primary.ID)
 
-		err = resourceTFEWorkspaceRead(rd, *testAccConfiguredClient)
-		if err != nil && !errors.Is(err, tfe.ErrResourceNotFound) {
-			return fmt.Errorf("Could not re-read resource directly: %w", err)
+		if diags := resourceTFEWorkspaceRead(ctx, rd, *testAccConfiguredClient); diags.HasError() {
+			return fmt.Errorf("Could not re-read resource directly: %v", diags)
 		}
 
 		return nil
@@ -16,6 +16,7 @@ import (
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -24,9 +25,9 @@ func resourceTFEWorkspaceVariableSet() *schema.Resource {
 		Description: "Adds and removes a workspace from a variable set's scope." +
 			"\n\n~> **Note:** `tfe_variable_set` has a deprecated argument `workspace_ids` that should not be used alongside this resource. They manage the same attachments and are mutually exclusive.",
 
-		Create: resourceTFEWorkspaceVariableSetCreate,
-		Read:   resourceTFEWorkspaceVariableSetRead,
-		Delete: resourceTFEWorkspaceVariableSetDelete,
+		CreateWithoutTimeout: resourceTFEWorkspaceVariableSetCreate,
+		ReadWithoutTimeout:   resourceTFEWorkspaceVariableSetRead,
+		DeleteWithoutTimeout: resourceTFEWorkspaceVariableSetDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTFEWorkspaceVariableSetImporter,
 		},
@@ -55,7 +56,7 @@ func resourceTFEWorkspaceVariableSet() *schema.Resource {
 	}
 }
 
-func resourceTFEWorkspaceVariableSetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEWorkspaceVariableSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	vSID := d.Get("variable_set_id").(string)
@@ -66,17 +67,17 @@ func resourceTFEWorkspaceVariableSetCreate(d *schema.ResourceData, meta interfac
 
 	err := config.Client.VariableSets.ApplyToWorkspaces(ctx, vSID, &applyOptions)
 	if err != nil {
-		return fmt.Errorf(
-			"Error applying variable set id %s to workspace %s: %w", vSID, wID, err)
+		return diag.Errorf(
+			"Error applying variable set id %s to workspace %s: %s", vSID, wID, err)
 	}
 
 	id := encodeVariableSetWorkspaceAttachment(wID, vSID)
 	d.SetId(id)
 
-	return resourceTFEWorkspaceVariableSetRead(d, meta)
+	return resourceTFEWorkspaceVariableSetRead(ctx, d, meta)
 }
 
-func resourceTFEWorkspaceVariableSetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEWorkspaceVariableSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	wID := d.Get("workspace_id").(string)
@@ -92,7 +93,7 @@ func resourceTFEWorkspaceVariableSetRead(d *schema.ResourceData, meta interface{
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error reading configuration of variable set %s: %w", d.Id(), err)
+		return diag.Errorf("Error reading configuration of variable set %s: %s", d.Id(), err)
 	}
 
 	// Verify workspace listed in variable set
@@ -113,7 +114,7 @@ func resourceTFEWorkspaceVariableSetRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceTFEWorkspaceVariableSetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceTFEWorkspaceVariableSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(ConfiguredClient)
 
 	wID := d.Get("workspace_id").(string)
@@ -125,8 +126,8 @@ func resourceTFEWorkspaceVariableSetDelete(d *schema.ResourceData, meta interfac
 
 	err := config.Client.VariableSets.RemoveFromWorkspaces(ctx, vSID, &removeOptions)
 	if err != nil {
-		return fmt.Errorf(
-			"Error removing workspace %s from variable set %s: %w", wID, vSID, err)
+		return diag.Errorf(
+			"Error removing workspace %s from variable set %s: %s", wID, vSID, err)
 	}
 
 	return nil
@@ -0,0 +1,210 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+const (
+	runCleanupDiscard     = "discard"
+	runCleanupCancel      = "cancel"
+	runCleanupForceCancel = "force_cancel"
+)
+
+// runCleanupPastTenses describe the operations once done, such as "Discarded".
+var runCleanupPastTenses = map[string]string{
+	runCleanupDiscard:     "Discarded",
+	runCleanupCancel:      "Canceled",
+	runCleanupForceCancel: "Force canceled",
+}
+
+// defaultRunCleanupStatuses are the statuses of the runs that pile up in the
+// queue of a workspace, waiting to start or to be confirmed.
+var defaultRunCleanupStatuses = []string{
+	string(tfe.RunPending),
+	string(tfe.RunPlanned),
+	string(tfe.RunCostEstimated),
+	string(tfe.RunPolicyChecked),
+	string(tfe.RunPolicyOverride),
+	string(tfe.RunPostPlanCompleted),
+}
+
+// selectRunsCleanupWorkspaces returns the workspaces selected in the
+// configuration of the tfe_runs_cleanup action, which can also select the
+// workspaces of a project.
+func (c ConfiguredClient) selectRunsCleanupWorkspaces(ctx context.Context, config tfsdk.Config, data actionTFERunsCleanupModel) ([]*tfe.Workspace, diag.Diagnostics) {
+	if data.ProjectID.IsNull() {
+		return c.selectConfiguredWorkspaces(ctx, config, data.modelWorkspaceSelector)
+	}
+
+	filter, diags := data.filter(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+	filter.projectID = data.ProjectID.ValueString()
+
+	var organization string
+	if data.Organization.IsNull() {
+		project, err := c.Client.Projects.Read(ctx, filter.projectID)
+		if err != nil {
+			diags.AddError("Error selecting workspaces", fmt.Sprintf("error reading project %s: %s", filter.projectID, err))
+			return nil, diags
+		}
+		organization = project.Organization.Name
+	} else {
+		diags.Append(c.dataOrDefaultOrganization(ctx, config, &organization)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	workspaces, err := listFilteredWorkspaces(ctx, c.Client, organization, filter)
+	if err != nil {
+		diags.AddError("Error selecting workspaces", err.Error())
+	}
+	return workspaces, diags
+}
+
+// staleRun is a run selected for cleanup along with its workspace.
+type staleRun struct {
+	workspace *tfe.Workspace
+	run       *tfe.Run
+}
+
+func (r staleRun) String() string {
+	return fmt.Sprintf("%s in workspace %s (%s since %s)", r.run.ID, r.workspace.Name, r.run.Status, r.run.CreatedAt.Format(time.RFC3339))
+}
+
+// runCleanupResult is how cleaning up the stale runs went.
+type runCleanupResult struct {
+	// cleaned describe the runs that were discarded or canceled, or would
+	// be in a dry run.
+	cleaned []string
+
+	// skipped describe the runs that the operation does not apply to, such
+	// as a pending run that cannot be force canceled.
+	skipped []string
+}
+
+// summary describes the result, such as "Discarded 2 run(s)".
+func (r runCleanupResult) summary(operation string, dryRun bool) string {
+	pastTense := runCleanupPastTenses[operation]
+	verb := pastTense
+	if dryRun {
+		verb = "Would have " + strings.ToLower(pastTense)
+	}
+
+	summary := fmt.Sprintf("%s %d run(s)", verb, len(r.cleaned))
+	if len(r.skipped) > 0 {
+		summary += fmt.Sprintf(", skipped %d run(s) that cannot be %s", len(r.skipped), strings.ToLower(pastTense))
+	}
+	return summary + "."
+}
+
+// listStaleRuns lists the runs of the workspaces with one of the statuses that
+// were created before the cutoff, newest first. A zero cutoff selects the runs
+// of any age.
+func listStaleRuns(ctx context.Context, tfeClient *tfe.Client, workspaces []*tfe.Workspace, statuses []string, cutoff time.Time) ([]staleRun, error) {
+	var runs []staleRun
+	for _, ws := range workspaces {
+		options := &tfe.RunListOptions{
+			Status: strings.Join(statuses, ","),
+		}
+
+		for {
+			rl, err := tfeClient.Runs.List(ctx, ws.ID, options)
+			if err != nil {
+				return nil, fmt.Errorf("error listing runs of workspace %s: %w", ws.Name, err)
+			}
+
+			for _, run := range rl.Items {
+				if !cutoff.IsZero() && !run.CreatedAt.Before(cutoff) {
+					continue
+				}
+				runs = append(runs, staleRun{workspace: ws, run: run})
+			}
+
+			// Exit the loop when we've seen all pages.
+			if rl.CurrentPage >= rl.TotalPages {
+				break
+			}
+
+			// Update the page number to get the next page.
+			options.PageNumber = rl.NextPage
+		}
+	}
+	return runs, nil
+}
+
+// canCleanupRun reports whether the operation applies to the run in its
+// current status.
+func canCleanupRun(run *tfe.Run, operation string) bool {
+	if run.Actions == nil {
+		return false
+	}
+
+	switch operation {
+	case runCleanupDiscard:
+		return run.Actions.IsDiscardable
+	case runCleanupCancel:
+		return run.Actions.IsCancelable
+	case runCleanupForceCancel:
+		return run.Actions.IsForceCancelable
+	default:
+		return false
+	}
+}
+
+// cleanupRuns discards or cancels the runs with the comment. A dry run only
+// reports the runs it would clean up. Runs are cleaned up in the given order,
+// newest first, so that the pending runs of a workspace are discarded before
+// the run they were waiting for, and do not start in the meantime. It cleans
+// up as many runs as it can and returns the errors of the others.
+func cleanupRuns(ctx context.Context, tfeClient *tfe.Client, runs []staleRun, operation, comment string, dryRun bool, progress runProgressFunc) (runCleanupResult, error) {
+	var result runCleanupResult
+	var errs []error
+
+	verb := strings.ReplaceAll(operation, "_", " ")
+	for _, r := range runs {
+		if !canCleanupRun(r.run, operation) {
+			result.skipped = append(result.skipped, r.String())
+			progress(fmt.Sprintf("Skipping run %s: cannot %s it", r, verb))
+			continue
+		}
+
+		if dryRun {
+			result.cleaned = append(result.cleaned, r.String())
+			progress(fmt.Sprintf("Would %s run %s", verb, r))
+			continue
+		}
+
+		var err error
+		switch operation {
+		case runCleanupDiscard:
+			err = tfeClient.Runs.Discard(ctx, r.run.ID, tfe.RunDiscardOptions{Comment: tfe.String(comment)})
+		case runCleanupCancel:
+			err = tfeClient.Runs.Cancel(ctx, r.run.ID, tfe.RunCancelOptions{Comment: tfe.String(comment)})
+		case runCleanupForceCancel:
+			err = tfeClient.Runs.ForceCancel(ctx, r.run.ID, tfe.RunForceCancelOptions{Comment: tfe.String(comment)})
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error trying to %s run %s: %w", verb, r, err))
+			continue
+		}
+
+		result.cleaned = append(result.cleaned, r.String())
+		progress(fmt.Sprintf("%s run %s", runCleanupPastTenses[operation], r))
+	}
+
+	return result, errors.Join(errs...)
+}
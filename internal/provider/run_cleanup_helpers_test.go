@@ -0,0 +1,137 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	tfemocks "github.com/hashicorp/go-tfe/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListFilteredWorkspaces_project(t *testing.T) {
+	client := testTfeClient(t, testClientOptions{})
+
+	ctrl := gomock.NewController(t)
+	mockWorkspacesAPI := tfemocks.NewMockWorkspaces(ctrl)
+	mockWorkspacesAPI.
+		EXPECT().
+		List(gomock.Any(), "hashicorp", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, options *tfe.WorkspaceListOptions) (*tfe.WorkspaceList, error) {
+			if options.ProjectID != "prj-01" {
+				t.Errorf("expected project filter prj-01, got %q", options.ProjectID)
+			}
+			return &tfe.WorkspaceList{
+				Pagination: &tfe.Pagination{CurrentPage: 1, TotalPages: 1},
+				Items: []*tfe.Workspace{
+					{ID: "ws-01", Name: "network-prod"},
+					{ID: "ws-02", Name: "network-staging"},
+				},
+			}, nil
+		})
+	client.Workspaces = mockWorkspacesAPI
+
+	workspaces, err := listFilteredWorkspaces(context.Background(), client, "hashicorp", workspaceFilter{
+		projectID: "prj-01",
+		names:     []string{"*-prod"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(workspaces) != 1 || workspaces[0].ID != "ws-01" {
+		t.Fatalf("expected only workspace ws-01 to be selected, got %v", workspaces)
+	}
+}
+
+func TestListStaleRuns(t *testing.T) {
+	client := testTfeClient(t, testClientOptions{})
+	now := time.Now()
+
+	ctrl := gomock.NewController(t)
+	mockRunsAPI := tfemocks.NewMockRuns(ctrl)
+	mockRunsAPI.
+		EXPECT().
+		List(gomock.Any(), "ws-01", &tfe.RunListOptions{Status: "pending,planned"}).
+		Return(&tfe.RunList{
+			Pagination: &tfe.Pagination{CurrentPage: 1, TotalPages: 1},
+			Items: []*tfe.Run{
+				{ID: "run-new", Status: tfe.RunPending, CreatedAt: now.Add(-time.Minute)},
+				{ID: "run-old", Status: tfe.RunPlanned, CreatedAt: now.Add(-2 * time.Hour)},
+			},
+		}, nil)
+	client.Runs = mockRunsAPI
+
+	ws := &tfe.Workspace{ID: "ws-01", Name: "network"}
+	runs, err := listStaleRuns(context.Background(), client, []*tfe.Workspace{ws}, []string{"pending", "planned"}, now.Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(runs) != 1 || runs[0].run.ID != "run-old" || runs[0].workspace != ws {
+		t.Fatalf("expected only run-old to be selected, got %v", runs)
+	}
+}
+
+func TestCleanupRuns(t *testing.T) {
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	ws := &tfe.Workspace{ID: "ws-01", Name: "network"}
+	runs := []staleRun{
+		{workspace: ws, run: &tfe.Run{ID: "run-01", Status: tfe.RunPending, CreatedAt: created, Actions: &tfe.RunActions{IsDiscardable: true}}},
+		{workspace: ws, run: &tfe.Run{ID: "run-02", Status: tfe.RunPlanning, CreatedAt: created, Actions: &tfe.RunActions{IsCancelable: true}}},
+		{workspace: ws, run: &tfe.Run{ID: "run-03", Status: tfe.RunPlanned, CreatedAt: created, Actions: &tfe.RunActions{IsDiscardable: true}}},
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		client := testTfeClient(t, testClientOptions{})
+		// No expected calls, the runs must be left as is.
+		client.Runs = tfemocks.NewMockRuns(gomock.NewController(t))
+
+		var messages []string
+		result, err := cleanupRuns(context.Background(), client, runs, runCleanupDiscard, "storm", true, func(message string) {
+			messages = append(messages, message)
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		expected := []string{
+			"Would discard run run-01 in workspace network (pending since 2026-01-02T03:04:05Z)",
+			"Skipping run run-02 in workspace network (planning since 2026-01-02T03:04:05Z): cannot discard it",
+			"Would discard run run-03 in workspace network (planned since 2026-01-02T03:04:05Z)",
+		}
+		if !reflect.DeepEqual(messages, expected) {
+			t.Fatalf("expected progress %q, got %q", expected, messages)
+		}
+		if summary := result.summary(runCleanupDiscard, true); summary != "Would have discarded 2 run(s), skipped 1 run(s) that cannot be discarded." {
+			t.Fatalf("unexpected summary %q", summary)
+		}
+	})
+
+	t.Run("discard", func(t *testing.T) {
+		client := testTfeClient(t, testClientOptions{})
+
+		ctrl := gomock.NewController(t)
+		mockRunsAPI := tfemocks.NewMockRuns(ctrl)
+		gomock.InOrder(
+			mockRunsAPI.EXPECT().Discard(gomock.Any(), "run-01", tfe.RunDiscardOptions{Comment: tfe.String("storm")}).Return(nil),
+			mockRunsAPI.EXPECT().Discard(gomock.Any(), "run-03", tfe.RunDiscardOptions{Comment: tfe.String("storm")}).Return(errors.New("conflict")),
+		)
+		client.Runs = mockRunsAPI
+
+		result, err := cleanupRuns(context.Background(), client, runs, runCleanupDiscard, "storm", false, func(string) {})
+		if err == nil {
+			t.Fatal("expected an error discarding run-03")
+		}
+		if len(result.cleaned) != 1 || len(result.skipped) != 1 {
+			t.Fatalf("expected 1 cleaned and 1 skipped run, got %+v", result)
+		}
+		if summary := result.summary(runCleanupDiscard, false); summary != "Discarded 1 run(s), skipped 1 run(s) that cannot be discarded." {
+			t.Fatalf("unexpected summary %q", summary)
+		}
+	})
+}
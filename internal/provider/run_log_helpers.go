@@ -0,0 +1,204 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+)
+
+// runLogErrorLines is the number of error lines of the log of a failed run
+// phase that are reported.
+const runLogErrorLines = 20
+
+// ansiEscapeRegexp matches the color codes of the logs of runs.
+var ansiEscapeRegexp = regexp.MustCompile(`\x1b\[[0-9;]*[A-Za-z]`)
+
+// runErroredError is returned when a run errors during its plan or its apply.
+// It carries the last error lines of the log of the failed phase, so that
+// they can be reported as the detail of the diagnostic.
+type runErroredError struct {
+	runID    string
+	phase    string
+	htmlURL  string
+	logLines []string
+}
+
+func (e *runErroredError) Error() string {
+	if e.htmlURL == "" {
+		return fmt.Sprintf("run errored during %s, use the run ID %s to debug error", e.phase, e.runID)
+	}
+	return fmt.Sprintf("run errored during %s, view the run %s on %s for details", e.phase, e.runID, e.htmlURL)
+}
+
+// detail is the excerpt of the log of the failed phase, or an empty string
+// when it has no error lines or could not be read.
+func (e *runErroredError) detail() string {
+	if len(e.logLines) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("Last error lines of the %s log:\n\n%s", e.phase, strings.Join(e.logLines, "\n"))
+}
+
+// newRunErroredError describes the run that errored during its plan or its
+// apply, reading its URL and the error lines of the log of the failed phase.
+// Failing to read them is only logged, as the run error is what matters.
+func newRunErroredError(ctx context.Context, tfeClient *tfe.Client, run *tfe.Run, isPlanOp bool) *runErroredError {
+	runErr := &runErroredError{runID: run.ID, phase: "apply"}
+	if isPlanOp {
+		runErr.phase = "plan"
+	}
+
+	result, err := readRunWithResult(ctx, tfeClient, run.ID)
+	if err != nil {
+		log.Printf("[WARN] Unable to read run %s: %v", run.ID, err)
+		return runErr
+	}
+	runErr.htmlURL = runHTMLURL(tfeClient, result)
+
+	logLines, err := readRunLogErrors(ctx, tfeClient, result, isPlanOp)
+	if err != nil {
+		log.Printf("[WARN] Unable to read the %s log of run %s: %v", runErr.phase, run.ID, err)
+		return runErr
+	}
+	runErr.logLines = logLines
+
+	return runErr
+}
+
+// readRunLogErrors reads the last error lines of the log of the plan or of the
+// apply of the run.
+func readRunLogErrors(ctx context.Context, tfeClient *tfe.Client, run *tfe.Run, isPlanOp bool) ([]string, error) {
+	var logs io.Reader
+	var err error
+	switch {
+	case isPlanOp && run.Plan != nil:
+		logs, err = tfeClient.Plans.Logs(ctx, run.Plan.ID)
+	case !isPlanOp && run.Apply != nil:
+		logs, err = tfeClient.Applies.Logs(ctx, run.Apply.ID)
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return runLogErrors(logs, runLogErrorLines)
+}
+
+// runLogErrors returns the last maxLines lines of the error diagnostics of the
+// log, without their color codes and borders. When structured run output is
+// enabled on the workspace, the lines of the log are JSON messages, and the
+// lines of their error diagnostics are returned instead.
+func runLogErrors(logs io.Reader, maxLines int) ([]string, error) {
+	var lines []string
+	inError, bordered := false, false
+
+	scanner := bufio.NewScanner(logs)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(ansiEscapeRegexp.ReplaceAllString(scanner.Text(), ""), " \r")
+
+		if structuredLines, ok := structuredLogErrors(line); ok {
+			lines = append(lines, structuredLines...)
+			inError = false
+			continue
+		}
+
+		// Error diagnostics are printed within a border, such as
+		//   ╷
+		//   │ Error: Invalid reference
+		//   │
+		//   │   on main.tf line 3:
+		//   ╵
+		// or without one by older versions of Terraform, until a blank line.
+		content, hasBorder := strings.CutPrefix(strings.TrimLeft(line, " "), "│")
+		if hasBorder {
+			content = strings.TrimPrefix(content, " ")
+		} else {
+			content = line
+		}
+		if strings.HasPrefix(content, "Error: ") {
+			inError, bordered = true, hasBorder
+			lines = append(lines, content)
+			continue
+		}
+		if !inError {
+			continue
+		}
+
+		switch {
+		case bordered && !hasBorder, !bordered && content == "":
+			inError = false
+		case strings.TrimSpace(content) != "":
+			lines = append(lines, content)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(lines) > maxLines {
+		lines = lines[len(lines)-maxLines:]
+	}
+	return lines, nil
+}
+
+// structuredLogMessage is a line of the log of a run with structured run
+// output enabled. See https://developer.hashicorp.com/terraform/internals/machine-readable-ui.
+type structuredLogMessage struct {
+	Level      string `json:"@level"`
+	Message    string `json:"@message"`
+	Diagnostic *struct {
+		Summary string `json:"summary"`
+		Detail  string `json:"detail"`
+		Address string `json:"address"`
+		Range   *struct {
+			Filename string `json:"filename"`
+			Start    struct {
+				Line int `json:"line"`
+			} `json:"start"`
+		} `json:"range"`
+	} `json:"diagnostic"`
+}
+
+// structuredLogErrors returns the lines of the error of a structured log
+// line, and whether the line is a structured log line at all.
+func structuredLogErrors(line string) ([]string, bool) {
+	if !strings.HasPrefix(line, "{") {
+		return nil, false
+	}
+
+	var message structuredLogMessage
+	if err := json.Unmarshal([]byte(line), &message); err != nil || message.Level == "" {
+		return nil, false
+	}
+	if message.Level != "error" {
+		return nil, true
+	}
+
+	lines := []string{message.Message}
+	if diagnostic := message.Diagnostic; diagnostic != nil {
+		if diagnostic.Address != "" {
+			lines = append(lines, "  with "+diagnostic.Address)
+		}
+		if diagnostic.Range != nil {
+			lines = append(lines, fmt.Sprintf("  on %s line %d", diagnostic.Range.Filename, diagnostic.Range.Start.Line))
+		}
+		for _, detail := range strings.Split(diagnostic.Detail, "\n") {
+			if strings.TrimSpace(detail) != "" {
+				lines = append(lines, "  "+detail)
+			}
+		}
+	}
+	return lines, true
+}
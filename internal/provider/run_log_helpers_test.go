@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRunLogErrors(t *testing.T) {
+	cases := map[string]struct {
+		logs     string
+		maxLines int
+		expected []string
+	}{
+		"bordered diagnostics": {
+			logs: strings.Join([]string{
+				"Terraform v1.14.0",
+				"\x1b[0m\x1b[1mnull_resource.test: Refreshing state...\x1b[0m",
+				"\x1b[31m╷\x1b[0m\x1b[0m",
+				"\x1b[31m│\x1b[0m \x1b[0m\x1b[1m\x1b[31mError: \x1b[0m\x1b[0m\x1b[1mUnsupported argument\x1b[0m",
+				"\x1b[31m│\x1b[0m \x1b[0m",
+				"\x1b[31m│\x1b[0m \x1b[0m\x1b[0m  on main.tf line 10, in variable \"name_length\":",
+				"\x1b[31m│\x1b[0m \x1b[0m  10:   \x1b[4mvalidation\x1b[0m = {\x1b[0m",
+				"\x1b[31m│\x1b[0m \x1b[0m",
+				"\x1b[31m│\x1b[0m \x1b[0mAn argument named \"validation\" is not expected here.",
+				"\x1b[31m╵\x1b[0m\x1b[0m",
+				"Operation failed: failed running terraform plan (exit 1)",
+			}, "\n"),
+			maxLines: 20,
+			expected: []string{
+				"Error: Unsupported argument",
+				"  on main.tf line 10, in variable \"name_length\":",
+				"  10:   validation = {",
+				"An argument named \"validation\" is not expected here.",
+			},
+		},
+		"unbordered diagnostics": {
+			logs: strings.Join([]string{
+				"Error: Invalid reference",
+				"",
+				"  on main.tf line 3:",
+				"",
+				"Error: Missing required argument",
+				"  The argument \"name\" is required.",
+				"",
+				"Operation failed",
+			}, "\n"),
+			maxLines: 20,
+			expected: []string{
+				"Error: Invalid reference",
+				"Error: Missing required argument",
+				"  The argument \"name\" is required.",
+			},
+		},
+		"structured run output": {
+			logs: strings.Join([]string{
+				`{"@level":"info","@message":"Terraform 1.14.0","@module":"terraform.ui","type":"version"}`,
+				`{"@level":"error","@message":"Error: Invalid count argument","@module":"terraform.ui","diagnostic":{"severity":"error","summary":"Invalid count argument","detail":"The \"count\" value depends on resource attributes.\n\nTo work around this, use -target.","address":"aws_instance.web","range":{"filename":"main.tf","start":{"line":12}}},"type":"diagnostic"}`,
+				`{"@level":"error","@message":"Error: Unauthorized","@module":"terraform.ui","type":"diagnostic"}`,
+			}, "\n"),
+			maxLines: 20,
+			expected: []string{
+				"Error: Invalid count argument",
+				"  with aws_instance.web",
+				"  on main.tf line 12",
+				"  The \"count\" value depends on resource attributes.",
+				"  To work around this, use -target.",
+				"Error: Unauthorized",
+			},
+		},
+		"last lines": {
+			logs:     "Error: first\nError: second\nError: third\n",
+			maxLines: 2,
+			expected: []string{"Error: second", "Error: third"},
+		},
+		"no errors": {
+			logs:     "Terraform v1.14.0\nNo changes. Your infrastructure matches the configuration.\n",
+			maxLines: 20,
+			expected: nil,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			lines, err := runLogErrors(strings.NewReader(tc.logs), tc.maxLines)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(lines, tc.expected) {
+				t.Fatalf("expected lines:\n%q\ngot:\n%q", tc.expected, lines)
+			}
+		})
+	}
+}
+
+func TestRunErroredError(t *testing.T) {
+	runErr := &runErroredError{runID: "run-01", phase: "plan"}
+	if runErr.Error() != "run errored during plan, use the run ID run-01 to debug error" {
+		t.Fatalf("unexpected error: %s", runErr.Error())
+	}
+	if runErr.detail() != "" {
+		t.Fatalf("expected no detail, got %q", runErr.detail())
+	}
+
+	runErr = &runErroredError{
+		runID:    "run-01",
+		phase:    "apply",
+		htmlURL:  "https://app.terraform.io/app/hashicorp/workspaces/network/runs/run-01",
+		logLines: []string{"Error: Unauthorized", "  with aws_instance.web"},
+	}
+	if runErr.Error() != "run errored during apply, view the run run-01 on https://app.terraform.io/app/hashicorp/workspaces/network/runs/run-01 for details" {
+		t.Fatalf("unexpected error: %s", runErr.Error())
+	}
+	if runErr.detail() != "Last error lines of the apply log:\n\nError: Unauthorized\n  with aws_instance.web" {
+		t.Fatalf("unexpected detail: %q", runErr.detail())
+	}
+}
@@ -35,6 +35,7 @@ type modelTFEStack struct {
 	VCSRepo            *modelTFEStackVCSRepo `tfsdk:"vcs_repo"`
 	CreatedAt          types.String          `tfsdk:"created_at"`
 	UpdatedAt          types.String          `tfsdk:"updated_at"`
+	Timeouts           *modelTimeouts        `tfsdk:"timeouts"`
 }
 
 type modelTFEStackIdentity struct {
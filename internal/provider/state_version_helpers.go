@@ -0,0 +1,206 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// stateVersionUnlockTimeout is how long to wait for the upload of a state
+// version to be processed before the workspace can be unlocked.
+const stateVersionUnlockTimeout = 2 * time.Minute
+
+// stateFile is the subset of a Terraform state file that is needed to upload
+// it as a state version.
+type stateFile struct {
+	Version int    `json:"version"`
+	Serial  *int64 `json:"serial"`
+	Lineage string `json:"lineage"`
+	Outputs map[string]struct {
+		Value interface{} `json:"value"`
+	} `json:"outputs"`
+}
+
+// parseStateFile parses a Terraform state file, which must be in the format of
+// Terraform 0.12 or later and have a serial and a lineage.
+func parseStateFile(raw []byte) (*stateFile, error) {
+	var state stateFile
+	if err := json.Unmarshal(raw, &state); err != nil {
+		return nil, fmt.Errorf("the state is not valid JSON: %w", err)
+	}
+	if state.Version != 4 {
+		return nil, fmt.Errorf("the state has version %d, only version 4 states, written by Terraform 0.12 or later, can be uploaded", state.Version)
+	}
+	if state.Serial == nil {
+		return nil, errors.New("the state has no serial")
+	}
+	if state.Lineage == "" {
+		return nil, errors.New("the state has no lineage")
+	}
+	return &state, nil
+}
+
+// stateMD5 returns the hex encoded MD5 hash of the raw state, as the API expects it.
+func stateMD5(raw []byte) string {
+	return fmt.Sprintf("%x", md5.Sum(raw))
+}
+
+// outputsValue is an object of the values of the outputs of the state, keyed
+// by output name.
+func (s *stateFile) outputsValue() (types.Dynamic, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attrTypes := map[string]attr.Type{}
+	attrValues := map[string]attr.Value{}
+	for name, output := range s.Outputs {
+		attrType, err := inferAttrType(output.Value)
+		if err != nil {
+			diags.AddError("Error inferring attribute type", fmt.Sprintf("Error inferring the type of output %q: %s", name, err))
+			return types.DynamicNull(), diags
+		}
+
+		attrValue, d := convertToAttrValue(output.Value, attrType)
+		diags.Append(d...)
+		if diags.HasError() {
+			return types.DynamicNull(), diags
+		}
+
+		attrTypes[name] = attrType
+		attrValues[name] = attrValue
+	}
+
+	obj, d := types.ObjectValue(attrTypes, attrValues)
+	diags.Append(d...)
+	return types.DynamicValue(obj), diags
+}
+
+// uploadStateVersion uploads the raw state as a new state version of the
+// workspace. The workspace is locked during the upload, as the Terraform CLI
+// does. Unless force is set, the upload is refused when the current state
+// version of the workspace has a newer serial or a different lineage than the
+// state.
+//
+// When the state version is uploaded but the workspace cannot be unlocked,
+// both the state version and the error are returned.
+func uploadStateVersion(ctx context.Context, tfeClient *tfe.Client, workspaceID string, raw []byte, state *stateFile, force bool) (*tfe.StateVersion, error) {
+	log.Printf("[DEBUG] Locking workspace %s to upload state", workspaceID)
+	_, err := tfeClient.Workspaces.Lock(ctx, workspaceID, tfe.WorkspaceLockOptions{
+		Reason: tfe.String("Locked by the tfe_state_version resource to upload state"),
+	})
+	if err != nil {
+		if errors.Is(err, tfe.ErrWorkspaceLocked) {
+			return nil, fmt.Errorf("workspace %s is already locked, unlock it before uploading state", workspaceID)
+		}
+		return nil, fmt.Errorf("error locking workspace %s: %w", workspaceID, err)
+	}
+
+	sv, err := pushState(ctx, tfeClient, workspaceID, raw, state, force)
+	if unlockErr := unlockStateVersionWorkspace(ctx, tfeClient, workspaceID); unlockErr != nil {
+		return sv, errors.Join(err, unlockErr)
+	}
+	return sv, err
+}
+
+func pushState(ctx context.Context, tfeClient *tfe.Client, workspaceID string, raw []byte, state *stateFile, force bool) (*tfe.StateVersion, error) {
+	current, err := tfeClient.StateVersions.ReadCurrent(ctx, workspaceID)
+	if err != nil && !errors.Is(err, tfe.ErrResourceNotFound) {
+		return nil, fmt.Errorf("error reading the current state version of workspace %s: %w", workspaceID, err)
+	}
+	if current != nil && current.Serial > *state.Serial {
+		if !force {
+			return nil, fmt.Errorf("the current state version %s of workspace %s has serial %d, which is newer than the serial %d of the state to upload. Set force to true to upload the state anyway", current.ID, workspaceID, current.Serial, *state.Serial)
+		}
+		log.Printf("[WARN] Forcing the upload of state with serial %d over state version %s with serial %d", *state.Serial, current.ID, current.Serial)
+	}
+	if current != nil && !force {
+		lineage, err := currentStateLineage(ctx, tfeClient, current)
+		if err != nil {
+			return nil, fmt.Errorf("error reading the lineage of the current state version %s of workspace %s: %w", current.ID, workspaceID, err)
+		}
+		if lineage != "" && lineage != state.Lineage {
+			return nil, fmt.Errorf("the current state version %s of workspace %s has lineage %s, which differs from the lineage %s of the state to upload. Set force to true to upload the state anyway", current.ID, workspaceID, lineage, state.Lineage)
+		}
+	}
+
+	options := tfe.StateVersionCreateOptions{
+		Lineage: tfe.String(state.Lineage),
+		MD5:     tfe.String(stateMD5(raw)),
+		Serial:  tfe.Int64(*state.Serial),
+		Force:   tfe.Bool(force),
+	}
+
+	log.Printf("[DEBUG] Uploading state with serial %d to workspace %s", *state.Serial, workspaceID)
+	sv, err := tfeClient.StateVersions.Upload(ctx, workspaceID, tfe.StateVersionUploadOptions{
+		StateVersionCreateOptions: options,
+		RawState:                  raw,
+	})
+	if errors.Is(err, tfe.ErrStateVersionUploadNotSupported) {
+		// Older versions of Terraform Enterprise only accept the state in the
+		// request that creates the state version.
+		log.Printf("[DEBUG] Direct state upload is not supported, creating the state version with the state")
+		options.State = tfe.String(base64.StdEncoding.EncodeToString(raw))
+		sv, err = tfeClient.StateVersions.Create(ctx, workspaceID, options)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error uploading state to workspace %s: %w", workspaceID, err)
+	}
+
+	return sv, nil
+}
+
+// currentStateLineage returns the lineage of the state version, which is only
+// part of the state itself, or an empty string when the state cannot be
+// downloaded yet.
+func currentStateLineage(ctx context.Context, tfeClient *tfe.Client, sv *tfe.StateVersion) (string, error) {
+	if sv.DownloadURL == "" {
+		log.Printf("[WARN] State version %s has no download URL, skipping the lineage check", sv.ID)
+		return "", nil
+	}
+
+	raw, err := tfeClient.StateVersions.Download(ctx, sv.DownloadURL)
+	if err != nil {
+		return "", err
+	}
+
+	var state struct {
+		Lineage string `json:"lineage"`
+	}
+	if err := json.Unmarshal(raw, &state); err != nil {
+		return "", fmt.Errorf("the state is not valid JSON: %w", err)
+	}
+	return state.Lineage, nil
+}
+
+// unlockStateVersionWorkspace unlocks the workspace, waiting for the uploaded
+// state version to be processed, as the workspace cannot be unlocked before.
+func unlockStateVersionWorkspace(ctx context.Context, tfeClient *tfe.Client, workspaceID string) error {
+	log.Printf("[DEBUG] Unlocking workspace %s", workspaceID)
+	err := retry.RetryContext(ctx, stateVersionUnlockTimeout, func() *retry.RetryError {
+		_, err := tfeClient.Workspaces.Unlock(ctx, workspaceID)
+		switch {
+		case errors.Is(err, tfe.ErrWorkspaceLockedStateVersionStillPending):
+			return retry.RetryableError(err)
+		case err != nil:
+			return retry.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error unlocking workspace %s, unlock it manually: %w", workspaceID, err)
+	}
+	return nil
+}
@@ -0,0 +1,230 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+	tfemocks "github.com/hashicorp/go-tfe/mocks"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.uber.org/mock/gomock"
+)
+
+func readStateFixture(t *testing.T, fileName string) ([]byte, *stateFile) {
+	t.Helper()
+	raw, err := os.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	state, err := parseStateFile(raw)
+	if err != nil {
+		t.Fatalf("unexpected error parsing %s: %s", fileName, err)
+	}
+	return raw, state
+}
+
+func TestParseStateFile(t *testing.T) {
+	_, state := readStateFixture(t, "test-fixtures/state-versions/terraform.tfstate")
+	if *state.Serial != 2 || state.Lineage != "b2b54b23-e7ea-5500-7b15-fcb68c1d92bb" {
+		t.Fatalf("unexpected serial %d and lineage %s", *state.Serial, state.Lineage)
+	}
+	if len(state.Outputs) != 7 {
+		t.Fatalf("expected 7 outputs, got %d", len(state.Outputs))
+	}
+
+	cases := map[string]struct {
+		raw string
+		err string
+	}{
+		"invalid JSON":    {raw: `{"version":`, err: "not valid JSON"},
+		"legacy version":  {raw: `{"version": 3, "serial": 1, "lineage": "abc"}`, err: "has version 3"},
+		"missing serial":  {raw: `{"version": 4, "lineage": "abc"}`, err: "no serial"},
+		"missing lineage": {raw: `{"version": 4, "serial": 1}`, err: "no lineage"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseStateFile([]byte(tc.raw))
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("expected an error containing %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestStateFile_outputsValue(t *testing.T) {
+	_, state := readStateFixture(t, "test-fixtures/state-versions/terraform.tfstate")
+	outputs, diags := state.outputsValue()
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	attrs := outputs.UnderlyingValue().(types.Object).Attributes()
+	if len(attrs) != 7 {
+		t.Fatalf("expected 7 outputs, got %s", outputs)
+	}
+	if attrs["test_output_string"].(types.String).ValueString() != "9023256633839603543" {
+		t.Fatalf("unexpected test_output_string %s", attrs["test_output_string"])
+	}
+
+	_, state = readStateFixture(t, "test-fixtures/state-versions/terraform-empty-outputs.tfstate")
+	outputs, diags = state.outputsValue()
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if attrs := outputs.UnderlyingValue().(types.Object).Attributes(); len(attrs) != 0 {
+		t.Fatalf("expected no outputs, got %s", outputs)
+	}
+}
+
+func TestUploadStateVersion(t *testing.T) {
+	raw, state := readStateFixture(t, "test-fixtures/state-versions/terraform.tfstate")
+	lockOptions := tfe.WorkspaceLockOptions{
+		Reason: tfe.String("Locked by the tfe_state_version resource to upload state"),
+	}
+	createOptions := tfe.StateVersionCreateOptions{
+		Lineage: tfe.String(state.Lineage),
+		MD5:     tfe.String(stateMD5(raw)),
+		Serial:  tfe.Int64(2),
+		Force:   tfe.Bool(false),
+	}
+
+	setup := func(t *testing.T) (*tfe.Client, *tfemocks.MockWorkspaces, *tfemocks.MockStateVersions) {
+		client := testTfeClient(t, testClientOptions{})
+		ctrl := gomock.NewController(t)
+		mockWorkspacesAPI := tfemocks.NewMockWorkspaces(ctrl)
+		mockStateVersionsAPI := tfemocks.NewMockStateVersions(ctrl)
+		client.Workspaces = mockWorkspacesAPI
+		client.StateVersions = mockStateVersionsAPI
+		return client, mockWorkspacesAPI, mockStateVersionsAPI
+	}
+
+	t.Run("uploads the state within a lock", func(t *testing.T) {
+		client, mockWorkspacesAPI, mockStateVersionsAPI := setup(t)
+		gomock.InOrder(
+			mockWorkspacesAPI.EXPECT().Lock(gomock.Any(), "ws-01", lockOptions).Return(&tfe.Workspace{ID: "ws-01", Locked: true}, nil),
+			mockStateVersionsAPI.EXPECT().ReadCurrent(gomock.Any(), "ws-01").Return(nil, tfe.ErrResourceNotFound),
+			mockStateVersionsAPI.EXPECT().
+				Upload(gomock.Any(), "ws-01", tfe.StateVersionUploadOptions{StateVersionCreateOptions: createOptions, RawState: raw}).
+				Return(&tfe.StateVersion{ID: "sv-01", Serial: 2}, nil),
+			mockWorkspacesAPI.EXPECT().Unlock(gomock.Any(), "ws-01").Return(nil, tfe.ErrWorkspaceLockedStateVersionStillPending),
+			mockWorkspacesAPI.EXPECT().Unlock(gomock.Any(), "ws-01").Return(&tfe.Workspace{ID: "ws-01"}, nil),
+		)
+
+		sv, err := uploadStateVersion(context.Background(), client, "ws-01", raw, state, false)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if sv.ID != "sv-01" {
+			t.Fatalf("expected sv-01, got %s", sv.ID)
+		}
+	})
+
+	t.Run("creates the state version when uploads are not supported", func(t *testing.T) {
+		client, mockWorkspacesAPI, mockStateVersionsAPI := setup(t)
+		mockWorkspacesAPI.EXPECT().Lock(gomock.Any(), "ws-01", lockOptions).Return(&tfe.Workspace{ID: "ws-01", Locked: true}, nil)
+		mockStateVersionsAPI.EXPECT().ReadCurrent(gomock.Any(), "ws-01").Return(&tfe.StateVersion{ID: "sv-00", Serial: 1, DownloadURL: "https://archivist/sv-00"}, nil)
+		mockStateVersionsAPI.EXPECT().Download(gomock.Any(), "https://archivist/sv-00").Return(raw, nil)
+		mockStateVersionsAPI.EXPECT().Upload(gomock.Any(), "ws-01", gomock.Any()).Return(nil, tfe.ErrStateVersionUploadNotSupported)
+		mockStateVersionsAPI.EXPECT().
+			Create(gomock.Any(), "ws-01", gomock.Cond(func(options tfe.StateVersionCreateOptions) bool {
+				return options.State != nil && *options.State != "" && *options.MD5 == *createOptions.MD5
+			})).
+			Return(&tfe.StateVersion{ID: "sv-01", Serial: 2}, nil)
+		mockWorkspacesAPI.EXPECT().Unlock(gomock.Any(), "ws-01").Return(&tfe.Workspace{ID: "ws-01"}, nil)
+
+		sv, err := uploadStateVersion(context.Background(), client, "ws-01", raw, state, false)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if sv.ID != "sv-01" {
+			t.Fatalf("expected sv-01, got %s", sv.ID)
+		}
+	})
+
+	t.Run("refuses an older serial unless forced", func(t *testing.T) {
+		client, mockWorkspacesAPI, mockStateVersionsAPI := setup(t)
+		mockWorkspacesAPI.EXPECT().Lock(gomock.Any(), "ws-01", lockOptions).Return(&tfe.Workspace{ID: "ws-01", Locked: true}, nil).Times(2)
+		mockStateVersionsAPI.EXPECT().ReadCurrent(gomock.Any(), "ws-01").Return(&tfe.StateVersion{ID: "sv-00", Serial: 5}, nil).Times(2)
+		mockWorkspacesAPI.EXPECT().Unlock(gomock.Any(), "ws-01").Return(&tfe.Workspace{ID: "ws-01"}, nil).Times(2)
+
+		_, err := uploadStateVersion(context.Background(), client, "ws-01", raw, state, false)
+		if err == nil || !strings.Contains(err.Error(), "Set force to true") {
+			t.Fatalf("expected the upload to be refused, got %v", err)
+		}
+
+		forced := createOptions
+		forced.Force = tfe.Bool(true)
+		mockStateVersionsAPI.EXPECT().
+			Upload(gomock.Any(), "ws-01", tfe.StateVersionUploadOptions{StateVersionCreateOptions: forced, RawState: raw}).
+			Return(&tfe.StateVersion{ID: "sv-01", Serial: 2}, nil)
+
+		sv, err := uploadStateVersion(context.Background(), client, "ws-01", raw, state, true)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if sv.ID != "sv-01" {
+			t.Fatalf("expected sv-01, got %s", sv.ID)
+		}
+	})
+
+	t.Run("refuses a different lineage unless forced", func(t *testing.T) {
+		client, mockWorkspacesAPI, mockStateVersionsAPI := setup(t)
+		current := &tfe.StateVersion{ID: "sv-00", Serial: 1, DownloadURL: "https://archivist/sv-00"}
+		mockWorkspacesAPI.EXPECT().Lock(gomock.Any(), "ws-01", lockOptions).Return(&tfe.Workspace{ID: "ws-01", Locked: true}, nil).Times(2)
+		mockStateVersionsAPI.EXPECT().ReadCurrent(gomock.Any(), "ws-01").Return(current, nil).Times(2)
+		mockStateVersionsAPI.EXPECT().Download(gomock.Any(), "https://archivist/sv-00").Return([]byte(`{"version": 4, "serial": 1, "lineage": "other-lineage"}`), nil)
+		mockWorkspacesAPI.EXPECT().Unlock(gomock.Any(), "ws-01").Return(&tfe.Workspace{ID: "ws-01"}, nil).Times(2)
+
+		_, err := uploadStateVersion(context.Background(), client, "ws-01", raw, state, false)
+		if err == nil || !strings.Contains(err.Error(), "lineage other-lineage") || !strings.Contains(err.Error(), "lineage "+state.Lineage) {
+			t.Fatalf("expected the upload to be refused naming both lineages, got %v", err)
+		}
+
+		forced := createOptions
+		forced.Force = tfe.Bool(true)
+		mockStateVersionsAPI.EXPECT().
+			Upload(gomock.Any(), "ws-01", tfe.StateVersionUploadOptions{StateVersionCreateOptions: forced, RawState: raw}).
+			Return(&tfe.StateVersion{ID: "sv-01", Serial: 2}, nil)
+
+		sv, err := uploadStateVersion(context.Background(), client, "ws-01", raw, state, true)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if sv.ID != "sv-01" {
+			t.Fatalf("expected sv-01, got %s", sv.ID)
+		}
+	})
+
+	t.Run("refuses a locked workspace", func(t *testing.T) {
+		client, mockWorkspacesAPI, _ := setup(t)
+		mockWorkspacesAPI.EXPECT().Lock(gomock.Any(), "ws-01", lockOptions).Return(nil, tfe.ErrWorkspaceLocked)
+
+		_, err := uploadStateVersion(context.Background(), client, "ws-01", raw, state, false)
+		if err == nil || !strings.Contains(err.Error(), "already locked") {
+			t.Fatalf("expected a locked workspace error, got %v", err)
+		}
+	})
+
+	t.Run("returns the state version when unlocking fails", func(t *testing.T) {
+		client, mockWorkspacesAPI, mockStateVersionsAPI := setup(t)
+		unlockErr := errors.New("unauthorized")
+		mockWorkspacesAPI.EXPECT().Lock(gomock.Any(), "ws-01", lockOptions).Return(&tfe.Workspace{ID: "ws-01", Locked: true}, nil)
+		mockStateVersionsAPI.EXPECT().ReadCurrent(gomock.Any(), "ws-01").Return(nil, tfe.ErrResourceNotFound)
+		mockStateVersionsAPI.EXPECT().Upload(gomock.Any(), "ws-01", gomock.Any()).Return(&tfe.StateVersion{ID: "sv-01", Serial: 2}, nil)
+		mockWorkspacesAPI.EXPECT().Unlock(gomock.Any(), "ws-01").Return(nil, unlockErr)
+
+		sv, err := uploadStateVersion(context.Background(), client, "ws-01", raw, state, false)
+		if sv == nil || sv.ID != "sv-01" {
+			t.Fatalf("expected sv-01, got %v", sv)
+		}
+		if !errors.Is(err, unlockErr) {
+			t.Fatalf("expected the unlock error, got %v", err)
+		}
+	})
+}
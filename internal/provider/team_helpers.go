@@ -11,8 +11,16 @@ import (
 	v2api "github.com/hashicorp/go-tfe/v2/api"
 	"github.com/hashicorp/go-tfe/v2/api/models"
 	"github.com/hashicorp/go-tfe/v2/api/organizations"
+	"github.com/hashicorp/terraform-provider-tfe/internal/client"
 )
 
+// lookupTeamByName is fetchTeamByNameV2 backed by the lookup cache.
+func (c ConfiguredClient) lookupTeamByName(ctx context.Context, orgName string, teamName string) (models.Teamsable, error) {
+	return client.Lookup(c.Lookups, client.LookupTeams, orgName+"/"+teamName, func() (models.Teamsable, error) {
+		return fetchTeamByNameV2(ctx, c.ClientV2.API, orgName, teamName)
+	})
+}
+
 // fetchTeamByNameV2 finds a team with an exact-match name in an organization
 // using the go-tfe v2 generated client, following pagination until it is
 // found or the pages are exhausted. This mirrors go-tfe v1's
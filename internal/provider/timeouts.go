@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-tfe/internal/provider/validators"
+)
+
+// modelTimeouts maps the timeouts block of plugin framework resources, the
+// counterpart of the timeouts of SDKv2 resources.
+type modelTimeouts struct {
+	Create types.String `tfsdk:"create"`
+	Update types.String `tfsdk:"update"`
+	Delete types.String `tfsdk:"delete"`
+}
+
+// timeoutsBlock is the schema of the timeouts block. The defaults are only
+// used in the descriptions.
+func timeoutsBlock(create, update, del time.Duration) schema.SingleNestedBlock {
+	attribute := func(operation string, def time.Duration) schema.StringAttribute {
+		return schema.StringAttribute{
+			Description: "How long to wait for the resource to be " + operation + ", such as `30m`. Defaults to `" + def.String() + "`.",
+			Optional:    true,
+			Validators: []validator.String{
+				validators.IsDuration(),
+			},
+		}
+	}
+
+	return schema.SingleNestedBlock{
+		Description: "Timeouts of the operations on the resource.",
+		Attributes: map[string]schema.Attribute{
+			"create": attribute("created", create),
+			"update": attribute("updated", update),
+			"delete": attribute("deleted", del),
+		},
+	}
+}
+
+func (t *modelTimeouts) create(def time.Duration) time.Duration {
+	if t == nil {
+		return def
+	}
+	return durationOrDefault(t.Create, def)
+}
+
+func (t *modelTimeouts) update(def time.Duration) time.Duration {
+	if t == nil {
+		return def
+	}
+	return durationOrDefault(t.Update, def)
+}
+
+func (t *modelTimeouts) delete(def time.Duration) time.Duration {
+	if t == nil {
+		return def
+	}
+	return durationOrDefault(t.Delete, def)
+}
+
+func durationOrDefault(value types.String, def time.Duration) time.Duration {
+	if value.IsNull() || value.IsUnknown() {
+		return def
+	}
+	d, err := time.ParseDuration(value.ValueString())
+	if err != nil || d <= 0 {
+		return def
+	}
+	return d
+}
+
+// retryUntilTimeout is retry.RetryContext, except that it returns a
+// *retry.TimeoutError wrapping the last error when the timeout expires while
+// retrying. retry.RetryContext returns the last retryable error itself, which
+// cannot be told apart from other errors.
+func retryUntilTimeout(ctx context.Context, timeout time.Duration, f retry.RetryFunc) error {
+	var retrying bool
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		rerr := f()
+		retrying = rerr != nil && rerr.Retryable
+		return rerr
+	})
+	if err == nil || !retrying {
+		return err
+	}
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return fmt.Errorf("%w: %w", ctxErr, err)
+	}
+	return &retry.TimeoutError{LastError: err, Timeout: timeout}
+}
+
+// isTimeoutError reports whether err was caused by the timeout of an
+// operation expiring, either while retrying or through the deadline of its
+// context.
+func isTimeoutError(err error) bool {
+	var timeoutErr *retry.TimeoutError
+	return errors.As(err, &timeoutErr) || errors.Is(err, context.DeadlineExceeded)
+}
@@ -16,10 +16,19 @@ import (
 	"strings"
 
 	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-provider-tfe/internal/client"
 )
 
+// lookupTerraformVersionID is fetchTerraformVersionID backed by the lookup
+// cache.
+func (c ConfiguredClient) lookupTerraformVersionID(ctx context.Context, version string) (string, error) {
+	return client.Lookup(c.Lookups, client.LookupTerraformVersions, version, func() (string, error) {
+		return fetchTerraformVersionID(ctx, version, c.Client)
+	})
+}
+
 // fetchTerraformVersionID returns a Terraform Version ID for the given Terraform version number
-func fetchTerraformVersionID(version string, client *tfe.Client) (string, error) {
+func fetchTerraformVersionID(ctx context.Context, version string, client *tfe.Client) (string, error) {
 	versions, err := client.Admin.TerraformVersions.List(ctx, &tfe.AdminTerraformVersionsListOptions{
 		Filter: version,
 	})
@@ -62,7 +71,7 @@ func fetchTerraformVersionID(version string, client *tfe.Client) (string, error)
 }
 
 // fetchSentinelVersionID returns a Sentinel Version ID for the given Sentinel version number
-func fetchSentinelVersionID(version string, client *tfe.Client) (string, error) {
+func fetchSentinelVersionID(ctx context.Context, version string, client *tfe.Client) (string, error) {
 	versions, err := client.Admin.SentinelVersions.List(ctx, &tfe.AdminSentinelVersionsListOptions{
 		Filter: version,
 	})
@@ -105,7 +114,7 @@ func fetchSentinelVersionID(version string, client *tfe.Client) (string, error)
 }
 
 // fetchOPAVersionID returns a OPA Version ID for the given OPA version number
-func fetchOPAVersionID(version string, client *tfe.Client) (string, error) {
+func fetchOPAVersionID(ctx context.Context, version string, client *tfe.Client) (string, error) {
 	versions, err := client.Admin.OPAVersions.List(ctx, &tfe.AdminOPAVersionsListOptions{
 		Filter: version,
 	})
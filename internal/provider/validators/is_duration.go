@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type isDurationValidator struct{}
+
+func (v isDurationValidator) Description(_ context.Context) string {
+	return "string is a valid duration, such as \"30s\" or \"2h45m\""
+}
+
+func (v isDurationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v isDurationValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+
+	if d, err := time.ParseDuration(value); err != nil || d <= 0 {
+		response.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(
+			request.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("%q is not a positive duration, such as \"30s\" or \"2h45m\"", value),
+		))
+	}
+}
+
+func IsDuration() validator.String {
+	return isDurationValidator{}
+}
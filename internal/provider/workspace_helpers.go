@@ -10,11 +10,28 @@ import (
 
 	tfe "github.com/hashicorp/go-tfe"
 	tfev2 "github.com/hashicorp/go-tfe/v2"
+	"github.com/hashicorp/terraform-provider-tfe/internal/client"
 )
 
+// lookupWorkspaceExternalID is fetchWorkspaceExternalID backed by the lookup
+// cache.
+func (c ConfiguredClient) lookupWorkspaceExternalID(ctx context.Context, id string) (string, error) {
+	return client.Lookup(c.Lookups, client.LookupWorkspaces, id, func() (string, error) {
+		return fetchWorkspaceExternalID(ctx, id, c.Client)
+	})
+}
+
+// lookupWorkspaceExternalIDV2 is fetchWorkspaceExternalIDV2 backed by the
+// lookup cache, which it shares with lookupWorkspaceExternalID.
+func (c ConfiguredClient) lookupWorkspaceExternalIDV2(ctx context.Context, id string) (string, error) {
+	return client.Lookup(c.Lookups, client.LookupWorkspaces, id, func() (string, error) {
+		return fetchWorkspaceExternalIDV2(ctx, id, c.ClientV2)
+	})
+}
+
 // fetchWorkspaceExternalID returns the external id for a workspace
 // when given a workspace id of the form ORGANIZATION_AME/WORKSPACE_NAME
-func fetchWorkspaceExternalID(id string, client *tfe.Client) (string, error) {
+func fetchWorkspaceExternalID(ctx context.Context, id string, client *tfe.Client) (string, error) {
 	orgName, wsName, err := unpackWorkspaceID(id)
 	if err != nil {
 		return "", fmt.Errorf("Error unpacking workspace ID: %w", err)
@@ -32,13 +49,13 @@ func fetchWorkspaceExternalID(id string, client *tfe.Client) (string, error) {
 // given a workspace id of the form ORGANIZATION_NAME/WORKSPACE_NAME, using
 // the go-tfe v2 generated client. It reuses fetchWorkspaceV2, defined
 // alongside the tfe_workspace_run_task resource.
-func fetchWorkspaceExternalIDV2(id string, client *tfev2.Client) (string, error) {
+func fetchWorkspaceExternalIDV2(ctx context.Context, id string, client *tfev2.Client) (string, error) {
 	orgName, wsName, err := unpackWorkspaceID(id)
 	if err != nil {
 		return "", fmt.Errorf("Error unpacking workspace ID: %w", err)
 	}
 
-	workspace, err := fetchWorkspaceV2(wsName, orgName, client)
+	workspace, err := fetchWorkspaceV2(ctx, wsName, orgName, client)
 	if err != nil {
 		return "", fmt.Errorf("Error reading configuration of workspace %s: %w", id, err)
 	}
@@ -52,7 +69,7 @@ type workspaceIDReader interface {
 
 // fetchWorkspaceHumanID returns the human readable id "org/workspace"
 // when given a workspace external id
-func fetchWorkspaceHumanID(id string, r workspaceIDReader) (string, error) {
+func fetchWorkspaceHumanID(ctx context.Context, id string, r workspaceIDReader) (string, error) {
 	workspace, err := r.ReadByID(ctx, id)
 	if err != nil {
 		return "", fmt.Errorf("Error reading configuration of workspace %s: %w", id, err)
@@ -88,7 +105,7 @@ func unpackWorkspaceID(id string) (organization, name string, err error) {
 	return s[0], s[1], nil
 }
 
-func readWorkspaceStateConsumers(id string, client *tfe.Client) (bool, []string, error) {
+func readWorkspaceStateConsumers(ctx context.Context, id string, client *tfe.Client) (bool, []string, error) {
 	options := &tfe.RemoteStateConsumersListOptions{ListOptions: tfe.ListOptions{PageSize: 100}}
 	remoteStateConsumerIDs := make([]string, 0)
 
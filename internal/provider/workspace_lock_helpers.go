@@ -0,0 +1,352 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-plugin-framework-validators/actionvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// modelWorkspaceSelector selects the workspaces of the workspace lock actions,
+// either by ID or like the tfe_workspace_ids data source.
+type modelWorkspaceSelector struct {
+	WorkspaceIDs types.Set    `tfsdk:"workspace_ids"`
+	Names        types.List   `tfsdk:"names"`
+	TagFilters   types.Object `tfsdk:"tag_filters"`
+	Organization types.String `tfsdk:"organization"`
+}
+
+type modelWorkspaceTagFilters struct {
+	Include types.Map `tfsdk:"include"`
+	Exclude types.Map `tfsdk:"exclude"`
+}
+
+// workspaceSelectorAttributes are the schema attributes of
+// modelWorkspaceSelector.
+func workspaceSelectorAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"workspace_ids": schema.SetAttribute{
+			Description: "The IDs of the workspaces. Conflicts with `names` and `tag_filters`.",
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"names": schema.ListAttribute{
+			Description: "A list of workspace names to search for, supporting the same wildcards as the `tfe_workspace_ids` data source, like `[\"*-prod\"]`. Names that don't match a workspace are ignored.",
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"tag_filters": schema.SingleNestedAttribute{
+			Description: "Key-value tag filters to search for workspaces. When set with `names`, the workspaces must match both.",
+			Optional:    true,
+			Attributes: map[string]schema.Attribute{
+				"include": schema.MapAttribute{
+					Description: "A map of key-value tags the workspaces must contain.",
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"exclude": schema.MapAttribute{
+					Description: "A map of key-value tags to exclude workspaces. To exclude all workspaces containing a specific key, use `\"*\"` as the value.",
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+			},
+		},
+		"organization": schema.StringAttribute{
+			Description: "The name of the organization to search for workspaces with `names` and `tag_filters`. Defaults to the provider organization.",
+			Optional:    true,
+		},
+	}
+}
+
+// workspaceSelectorConfigValidators require the workspaces to be selected
+// either by ID or by name and tags.
+func workspaceSelectorConfigValidators() []action.ConfigValidator {
+	return []action.ConfigValidator{
+		actionvalidator.AtLeastOneOf(
+			path.MatchRoot("workspace_ids"),
+			path.MatchRoot("names"),
+			path.MatchRoot("tag_filters"),
+		),
+		actionvalidator.Conflicting(
+			path.MatchRoot("workspace_ids"),
+			path.MatchRoot("names"),
+		),
+		actionvalidator.Conflicting(
+			path.MatchRoot("workspace_ids"),
+			path.MatchRoot("tag_filters"),
+		),
+	}
+}
+
+// workspaceLockResult is how locking or unlocking a set of workspaces went.
+type workspaceLockResult struct {
+	// changed are the names of the workspaces whose lock state changed.
+	changed []string
+
+	// unchanged are the names of the workspaces already in the requested
+	// lock state.
+	unchanged []string
+
+	// held describe the workspaces that are locked by another run, user or
+	// team, such as "my-workspace (locked by run run-CZcmD7eagjhyXavN)".
+	held []string
+}
+
+// summary describes the result, using verb for the changed workspaces, such
+// as "Locked".
+func (r workspaceLockResult) summary(verb, unchangedState string) string {
+	parts := []string{fmt.Sprintf("%s %d workspace(s)", verb, len(r.changed))}
+	if len(r.changed) > 0 {
+		parts[0] += ": " + strings.Join(r.changed, ", ")
+	}
+	if len(r.unchanged) > 0 {
+		parts = append(parts, fmt.Sprintf("%d workspace(s) already %s: %s", len(r.unchanged), unchangedState, strings.Join(r.unchanged, ", ")))
+	}
+	if len(r.held) > 0 {
+		parts = append(parts, fmt.Sprintf("%d workspace(s) held by another lock: %s", len(r.held), strings.Join(r.held, ", ")))
+	}
+	return strings.Join(parts, ". ") + "."
+}
+
+// workspaceFilter selects the workspaces of an organization by project, name
+// and tags, like the tfe_workspace_ids data source.
+type workspaceFilter struct {
+	// projectID is the ID of the project of the workspaces, if any.
+	projectID string
+
+	names   []string
+	include map[string]string
+	exclude map[string]string
+}
+
+// filter returns the names and tag filters of the selector.
+func (s modelWorkspaceSelector) filter(ctx context.Context) (workspaceFilter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var filter workspaceFilter
+	if !s.Names.IsNull() {
+		diags.Append(s.Names.ElementsAs(ctx, &filter.names, false)...)
+	}
+	if !s.TagFilters.IsNull() {
+		var tagFilters modelWorkspaceTagFilters
+		diags.Append(s.TagFilters.As(ctx, &tagFilters, basetypes.ObjectAsOptions{})...)
+		if !tagFilters.Include.IsNull() {
+			diags.Append(tagFilters.Include.ElementsAs(ctx, &filter.include, false)...)
+		}
+		if !tagFilters.Exclude.IsNull() {
+			diags.Append(tagFilters.Exclude.ElementsAs(ctx, &filter.exclude, false)...)
+		}
+	}
+	return filter, diags
+}
+
+// selectConfiguredWorkspaces returns the workspaces selected in the
+// configuration of a workspace lock action.
+func (c ConfiguredClient) selectConfiguredWorkspaces(ctx context.Context, config tfsdk.Config, selector modelWorkspaceSelector) ([]*tfe.Workspace, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var workspaceIDs []string
+	var organization string
+	if !selector.WorkspaceIDs.IsNull() {
+		diags.Append(selector.WorkspaceIDs.ElementsAs(ctx, &workspaceIDs, false)...)
+	} else {
+		diags.Append(c.dataOrDefaultOrganization(ctx, config, &organization)...)
+	}
+
+	filter, filterDiags := selector.filter(ctx)
+	diags.Append(filterDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	workspaces, err := selectWorkspaces(ctx, c.Client, organization, workspaceIDs, filter.names, filter.include, filter.exclude)
+	if err != nil {
+		diags.AddError("Error selecting workspaces", err.Error())
+	}
+	return workspaces, diags
+}
+
+// selectWorkspaces reads the workspaces with the given IDs or, when there are
+// none, lists the workspaces of the organization matching the names and tag
+// filters. The workspaces include who locked them.
+func selectWorkspaces(ctx context.Context, tfeClient *tfe.Client, organization string, workspaceIDs, names []string, include, exclude map[string]string) ([]*tfe.Workspace, error) {
+	if len(workspaceIDs) > 0 {
+		workspaces := make([]*tfe.Workspace, 0, len(workspaceIDs))
+		for _, id := range workspaceIDs {
+			ws, err := tfeClient.Workspaces.ReadByIDWithOptions(ctx, id, &tfe.WorkspaceReadOptions{
+				Include: []tfe.WSIncludeOpt{tfe.WSLockedBy},
+			})
+			if err != nil {
+				return nil, fmt.Errorf("error reading workspace %s: %w", id, err)
+			}
+			workspaces = append(workspaces, ws)
+		}
+		return workspaces, nil
+	}
+
+	return listFilteredWorkspaces(ctx, tfeClient, organization, workspaceFilter{names: names, include: include, exclude: exclude})
+}
+
+// listFilteredWorkspaces lists the workspaces of the organization matching
+// the filter, including who locked them.
+func listFilteredWorkspaces(ctx context.Context, tfeClient *tfe.Client, organization string, filter workspaceFilter) ([]*tfe.Workspace, error) {
+	nameSet := make(map[string]bool, len(filter.names))
+	for _, name := range filter.names {
+		nameSet[name] = true
+	}
+
+	options := &tfe.WorkspaceListOptions{
+		ProjectID: filter.projectID,
+		Include:   []tfe.WSIncludeOpt{tfe.WSLockedBy, tfe.WSEffectiveTagBindings},
+	}
+	for key, value := range filter.include {
+		options.TagBindings = append(options.TagBindings, &tfe.TagBinding{Key: key, Value: value})
+	}
+
+	var workspaces []*tfe.Workspace
+	for {
+		wl, err := tfeClient.Workspaces.List(ctx, organization, options)
+		if err != nil {
+			return nil, fmt.Errorf("error listing workspaces of organization %s: %w", organization, err)
+		}
+
+		for _, ws := range wl.Items {
+			if len(nameSet) > 0 && !includedByName(nameSet, ws.Name) {
+				continue
+			}
+			if hasExcludedTagBinding(ws, filter.exclude) {
+				continue
+			}
+			workspaces = append(workspaces, ws)
+		}
+
+		// Exit the loop when we've seen all pages.
+		if wl.CurrentPage >= wl.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = wl.NextPage
+	}
+
+	return workspaces, nil
+}
+
+func hasExcludedTagBinding(ws *tfe.Workspace, exclude map[string]string) bool {
+	for _, binding := range ws.EffectiveTagBindings {
+		if value, ok := exclude[binding.Key]; ok && (value == binding.Value || value == "*") {
+			return true
+		}
+	}
+	return false
+}
+
+// workspaceLockHolder describes who locked the workspace, such as "run
+// run-CZcmD7eagjhyXavN".
+func workspaceLockHolder(ws *tfe.Workspace) string {
+	switch {
+	case ws.LockedBy == nil:
+		return "an unknown holder"
+	case ws.LockedBy.Run != nil:
+		return "run " + ws.LockedBy.Run.ID
+	case ws.LockedBy.User != nil && ws.LockedBy.User.Username != "":
+		return "user " + ws.LockedBy.User.Username
+	case ws.LockedBy.User != nil:
+		return "user " + ws.LockedBy.User.ID
+	case ws.LockedBy.Team != nil && ws.LockedBy.Team.Name != "":
+		return "team " + ws.LockedBy.Team.Name
+	case ws.LockedBy.Team != nil:
+		return "team " + ws.LockedBy.Team.ID
+	default:
+		return "an unknown holder"
+	}
+}
+
+func heldWorkspace(ws *tfe.Workspace) string {
+	return fmt.Sprintf("%s (locked by %s)", ws.Name, workspaceLockHolder(ws))
+}
+
+// lockWorkspaces locks the workspaces that are not locked yet. Workspaces
+// that are already locked are reported as held. It locks as many workspaces
+// as it can and returns the errors of the others.
+func lockWorkspaces(ctx context.Context, tfeClient *tfe.Client, workspaces []*tfe.Workspace, reason string, progress runProgressFunc) (workspaceLockResult, error) {
+	var result workspaceLockResult
+	var errs []error
+
+	for _, ws := range workspaces {
+		if ws.Locked {
+			result.held = append(result.held, heldWorkspace(ws))
+			progress(fmt.Sprintf("Workspace %s is already locked by %s", ws.Name, workspaceLockHolder(ws)))
+			continue
+		}
+
+		_, err := tfeClient.Workspaces.Lock(ctx, ws.ID, tfe.WorkspaceLockOptions{
+			Reason: tfe.String(reason),
+		})
+		switch {
+		case err == nil:
+			result.changed = append(result.changed, ws.Name)
+			progress(fmt.Sprintf("Locked workspace %s", ws.Name))
+		case errors.Is(err, tfe.ErrWorkspaceLocked):
+			// The workspace was locked since it was read.
+			result.held = append(result.held, heldWorkspace(ws))
+			progress(fmt.Sprintf("Workspace %s is already locked", ws.Name))
+		default:
+			errs = append(errs, fmt.Errorf("error locking workspace %s: %w", ws.Name, err))
+		}
+	}
+
+	return result, errors.Join(errs...)
+}
+
+// unlockWorkspaces unlocks the locked workspaces. Without force, workspaces
+// locked by a run or by another user or team are reported as held. It unlocks
+// as many workspaces as it can and returns the errors of the others.
+func unlockWorkspaces(ctx context.Context, tfeClient *tfe.Client, workspaces []*tfe.Workspace, force bool, progress runProgressFunc) (workspaceLockResult, error) {
+	var result workspaceLockResult
+	var errs []error
+
+	for _, ws := range workspaces {
+		if !ws.Locked {
+			result.unchanged = append(result.unchanged, ws.Name)
+			continue
+		}
+
+		var err error
+		if force {
+			_, err = tfeClient.Workspaces.ForceUnlock(ctx, ws.ID)
+		} else {
+			_, err = tfeClient.Workspaces.Unlock(ctx, ws.ID)
+		}
+		switch {
+		case err == nil:
+			result.changed = append(result.changed, ws.Name)
+			progress(fmt.Sprintf("Unlocked workspace %s", ws.Name))
+		case errors.Is(err, tfe.ErrWorkspaceNotLocked):
+			// The workspace was unlocked since it was read.
+			result.unchanged = append(result.unchanged, ws.Name)
+		case errors.Is(err, tfe.ErrWorkspaceLockedByRun),
+			errors.Is(err, tfe.ErrWorkspaceLockedByUser),
+			errors.Is(err, tfe.ErrWorkspaceLockedByTeam):
+			result.held = append(result.held, heldWorkspace(ws))
+			progress(fmt.Sprintf("Workspace %s is held by %s", ws.Name, workspaceLockHolder(ws)))
+		default:
+			errs = append(errs, fmt.Errorf("error unlocking workspace %s: %w", ws.Name, err))
+		}
+	}
+
+	return result, errors.Join(errs...)
+}
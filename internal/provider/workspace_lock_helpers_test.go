@@ -0,0 +1,151 @@
+// Copyright IBM Corp. 2018, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+	tfemocks "github.com/hashicorp/go-tfe/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSelectWorkspaces_namesAndTags(t *testing.T) {
+	client := testTfeClient(t, testClientOptions{})
+
+	ctrl := gomock.NewController(t)
+	mockWorkspacesAPI := tfemocks.NewMockWorkspaces(ctrl)
+	mockWorkspacesAPI.
+		EXPECT().
+		List(gomock.Any(), "hashicorp", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, options *tfe.WorkspaceListOptions) (*tfe.WorkspaceList, error) {
+			expected := []*tfe.TagBinding{{Key: "env", Value: "prod"}}
+			if !reflect.DeepEqual(options.TagBindings, expected) {
+				t.Errorf("expected tag bindings %v, got %v", expected, options.TagBindings)
+			}
+			return &tfe.WorkspaceList{
+				Pagination: &tfe.Pagination{CurrentPage: 1, TotalPages: 1},
+				Items: []*tfe.Workspace{
+					{ID: "ws-01", Name: "network-prod"},
+					{ID: "ws-02", Name: "compute-prod", EffectiveTagBindings: []*tfe.EffectiveTagBinding{{Key: "frozen", Value: "true"}}},
+					{ID: "ws-03", Name: "network-staging"},
+				},
+			}, nil
+		})
+	client.Workspaces = mockWorkspacesAPI
+
+	workspaces, err := selectWorkspaces(context.Background(), client, "hashicorp", nil, []string{"*-prod"}, map[string]string{"env": "prod"}, map[string]string{"frozen": "*"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(workspaces) != 1 || workspaces[0].ID != "ws-01" {
+		t.Fatalf("expected only workspace ws-01 to be selected, got %v", workspaces)
+	}
+}
+
+func TestLockWorkspaces(t *testing.T) {
+	client := testTfeClient(t, testClientOptions{})
+
+	ctrl := gomock.NewController(t)
+	mockWorkspacesAPI := tfemocks.NewMockWorkspaces(ctrl)
+	mockWorkspacesAPI.EXPECT().Lock(gomock.Any(), "ws-01", tfe.WorkspaceLockOptions{Reason: tfe.String("maintenance")}).Return(&tfe.Workspace{ID: "ws-01"}, nil)
+	mockWorkspacesAPI.EXPECT().Lock(gomock.Any(), "ws-03", gomock.Any()).Return(nil, errors.New("forbidden"))
+	client.Workspaces = mockWorkspacesAPI
+
+	workspaces := []*tfe.Workspace{
+		{ID: "ws-01", Name: "unlocked"},
+		{ID: "ws-02", Name: "running", Locked: true, LockedBy: &tfe.LockedByChoice{Run: &tfe.Run{ID: "run-01"}}},
+		{ID: "ws-03", Name: "forbidden"},
+	}
+
+	var messages []string
+	result, err := lockWorkspaces(context.Background(), client, workspaces, "maintenance", func(message string) {
+		messages = append(messages, message)
+	})
+	if err == nil || err.Error() != "error locking workspace forbidden: forbidden" {
+		t.Fatalf("expected an error locking workspace forbidden, got %v", err)
+	}
+
+	expected := workspaceLockResult{
+		changed: []string{"unlocked"},
+		held:    []string{"running (locked by run run-01)"},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf("expected result %+v, got %+v", expected, result)
+	}
+	if len(messages) != 2 {
+		t.Fatalf("expected 2 progress messages, got %q", messages)
+	}
+}
+
+func TestUnlockWorkspaces(t *testing.T) {
+	workspaces := []*tfe.Workspace{
+		{ID: "ws-01", Name: "locked-by-user", Locked: true, LockedBy: &tfe.LockedByChoice{User: &tfe.User{Username: "admin"}}},
+		{ID: "ws-02", Name: "locked-by-run", Locked: true, LockedBy: &tfe.LockedByChoice{Run: &tfe.Run{ID: "run-01"}}},
+		{ID: "ws-03", Name: "unlocked"},
+	}
+
+	t.Run("without force", func(t *testing.T) {
+		client := testTfeClient(t, testClientOptions{})
+
+		ctrl := gomock.NewController(t)
+		mockWorkspacesAPI := tfemocks.NewMockWorkspaces(ctrl)
+		mockWorkspacesAPI.EXPECT().Unlock(gomock.Any(), "ws-01").Return(&tfe.Workspace{ID: "ws-01"}, nil)
+		mockWorkspacesAPI.EXPECT().Unlock(gomock.Any(), "ws-02").Return(nil, tfe.ErrWorkspaceLockedByRun)
+		client.Workspaces = mockWorkspacesAPI
+
+		result, err := unlockWorkspaces(context.Background(), client, workspaces, false, func(string) {})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		expected := workspaceLockResult{
+			changed:   []string{"locked-by-user"},
+			unchanged: []string{"unlocked"},
+			held:      []string{"locked-by-run (locked by run run-01)"},
+		}
+		if !reflect.DeepEqual(result, expected) {
+			t.Fatalf("expected result %+v, got %+v", expected, result)
+		}
+	})
+
+	t.Run("with force", func(t *testing.T) {
+		client := testTfeClient(t, testClientOptions{})
+
+		ctrl := gomock.NewController(t)
+		mockWorkspacesAPI := tfemocks.NewMockWorkspaces(ctrl)
+		mockWorkspacesAPI.EXPECT().ForceUnlock(gomock.Any(), "ws-01").Return(&tfe.Workspace{ID: "ws-01"}, nil)
+		mockWorkspacesAPI.EXPECT().ForceUnlock(gomock.Any(), "ws-02").Return(&tfe.Workspace{ID: "ws-02"}, nil)
+		client.Workspaces = mockWorkspacesAPI
+
+		result, err := unlockWorkspaces(context.Background(), client, workspaces, true, func(string) {})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		expected := workspaceLockResult{
+			changed:   []string{"locked-by-user", "locked-by-run"},
+			unchanged: []string{"unlocked"},
+		}
+		if !reflect.DeepEqual(result, expected) {
+			t.Fatalf("expected result %+v, got %+v", expected, result)
+		}
+	})
+}
+
+func TestWorkspaceLockResult_summary(t *testing.T) {
+	result := workspaceLockResult{
+		changed:   []string{"a", "b"},
+		unchanged: []string{"c"},
+		held:      []string{"d (locked by team ops)"},
+	}
+
+	expected := "Unlocked 2 workspace(s): a, b. 1 workspace(s) already unlocked: c. 1 workspace(s) held by another lock: d (locked by team ops)."
+	if got := result.summary("Unlocked", "unlocked"); got != expected {
+		t.Fatalf("expected summary %q, got %q", expected, got)
+	}
+}
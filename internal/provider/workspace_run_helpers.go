@@ -4,10 +4,15 @@
 package provider
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
+	"maps"
 	"math"
 	"math/rand"
+	"net/url"
+	"slices"
 	"strings"
 	"time"
 
@@ -15,7 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-func createWorkspaceRun(d *schema.ResourceData, meta interface{}, isDestroyRun bool, currentRetryAttempts int) error {
+func createWorkspaceRun(ctx context.Context, d *schema.ResourceData, meta interface{}, isDestroyRun bool, currentRetryAttempts int) error {
 	runArgs := getRunArgs(d, isDestroyRun)
 	if runArgs == nil {
 		return nil
@@ -32,7 +37,10 @@ func createWorkspaceRun(d *schema.ResourceData, meta interface{}, isDestroyRun b
 	if !isInitialRunAttempt {
 		select {
 		case <-ctx.Done():
-			return fmt.Errorf("context canceled: %w", ctx.Err())
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return fmt.Errorf("timed out before retrying the run: %w", ctx.Err())
+			}
+			return fmt.Errorf("operation cancelled before retrying the run: %w", ctx.Err())
 		case <-time.After(backoff(float64(retryBOMin), float64(retryBOMax), currentRetryAttempts)):
 		}
 	}
@@ -49,9 +57,8 @@ func createWorkspaceRun(d *schema.ResourceData, meta interface{}, isDestroyRun b
 
 	waitForRun := runArgs["wait_for_run"].(bool)
 	manualConfirm := runArgs["manual_confirm"].(bool)
-	msg, _ := runArgs["message"].(string)
 
-	run, err := createRun(config.Client, waitForRun, manualConfirm, isDestroyRun, ws, msg)
+	run, err := createRun(ctx, config.Client, waitForRun, manualConfirm, isDestroyRun, ws, runArgs)
 
 	if err != nil {
 		// A destroy run against a workspace with no configuration version
@@ -73,13 +80,19 @@ func createWorkspaceRun(d *schema.ResourceData, meta interface{}, isDestroyRun b
 	}
 
 	isPlanOp := true
-	hasPostPlanTaskStage, err := readPostPlanTaskStageInRun(config.Client, run.ID)
+	hasPostPlanTaskStage, err := readPostPlanTaskStageInRun(ctx, config.Client, run.ID)
 	if err != nil {
 		return err
 	}
 
 	planPendingStatuses, planTerminalStatuses := planStatuses(run, hasPostPlanTaskStage)
-	run, err = awaitRun(config.Client, run.ID, ws.Organization.Name, isPlanOp, planPendingStatuses, isPlanComplete(planTerminalStatuses))
+	overrideComment, override := getPolicyOverrideComment(runArgs)
+	if override {
+		// Only runs with a policy_override block stop at a post-plan stage
+		// awaiting a decision, other runs still fail there.
+		planTerminalStatuses[tfe.RunPostPlanAwaitingDecision] = true
+	}
+	run, err = awaitRun(ctx, config.Client, run, ws.Organization.Name, isPlanOp, planPendingStatuses, isPlanComplete(planTerminalStatuses))
 	if err != nil {
 		return err
 	}
@@ -88,22 +101,33 @@ func createWorkspaceRun(d *schema.ResourceData, meta interface{}, isDestroyRun b
 		if retry && currentRetryAttempts < retryMaxAttempts {
 			currentRetryAttempts++
 			log.Printf("[INFO] Run errored during plan, retrying run, retry count: %d", currentRetryAttempts)
-			return createWorkspaceRun(d, meta, isDestroyRun, currentRetryAttempts)
+			return createWorkspaceRun(ctx, d, meta, isDestroyRun, currentRetryAttempts)
 		}
 
-		return fmt.Errorf("run errored during plan, use the run ID %s to debug error", run.ID)
+		return newRunErroredError(ctx, config.Client, run, isPlanOp)
 	}
 
-	if run.Status == tfe.RunPolicyOverride {
-		log.Printf("[INFO] Policy check soft-failed, awaiting manual override for run %q", run.ID)
-		run, err = awaitRun(config.Client, run.ID, ws.Organization.Name, isPlanOp, policyOverridePendingStatuses, isManuallyOverriden)
+	if run.Status == tfe.RunPolicyOverride || run.Status == tfe.RunPostPlanAwaitingDecision {
+		if override {
+			if err := overrideRunPolicies(ctx, config.Client, run, overrideComment); err != nil {
+				return err
+			}
+		} else {
+			log.Printf("[INFO] Policies or run tasks failed, awaiting manual override for run %q", run.ID)
+		}
+
+		if run.Status == tfe.RunPolicyOverride {
+			run, err = awaitRun(ctx, config.Client, run, ws.Organization.Name, isPlanOp, policyOverridePendingStatuses, isManuallyOverriden)
+		} else {
+			run, err = awaitRun(ctx, config.Client, run, ws.Organization.Name, isPlanOp, postPlanOverridePendingStatuses, isPostPlanOverridden)
+		}
 		if err != nil {
 			return err
 		}
 	}
 
 	if !run.HasChanges && !run.AllowEmptyApply {
-		run, err = awaitRun(config.Client, run.ID, ws.Organization.Name, isPlanOp, confirmationPendingStatuses, isPlannedAndFinished)
+		run, err = awaitRun(ctx, config.Client, run, ws.Organization.Name, isPlanOp, confirmationPendingStatuses, isPlannedAndFinished)
 		if err != nil {
 			return err
 		}
@@ -116,23 +140,23 @@ func createWorkspaceRun(d *schema.ResourceData, meta interface{}, isDestroyRun b
 	}
 
 	// wait for run to be comfirmable before attempting to confirm
-	run, err = awaitRun(config.Client, run.ID, ws.Organization.Name, isPlanOp, confirmationPendingStatuses, isConfirmable)
+	run, err = awaitRun(ctx, config.Client, run, ws.Organization.Name, isPlanOp, confirmationPendingStatuses, isConfirmable)
 	if err != nil {
 		return err
 	}
 
-	err = confirmRun(config.Client, manualConfirm, isPlanOp, run, ws)
+	err = confirmRun(ctx, config.Client, manualConfirm, isPlanOp, run, ws)
 	if err != nil {
 		return err
 	}
 
 	isPlanOp = false
-	run, err = awaitRun(config.Client, run.ID, ws.Organization.Name, isPlanOp, applyPendingStatuses, isCompleted)
+	run, err = awaitRun(ctx, config.Client, run, ws.Organization.Name, isPlanOp, applyPendingStatuses, isCompleted)
 	if err != nil {
 		return err
 	}
 
-	return completeOrRetryRun(meta, run, d, retry, currentRetryAttempts, retryMaxAttempts, isDestroyRun)
+	return completeOrRetryRun(ctx, meta, run, d, retry, currentRetryAttempts, retryMaxAttempts, isDestroyRun)
 }
 
 func getRunArgs(d *schema.ResourceData, isDestroyRun bool) map[string]interface{} {
@@ -173,7 +197,7 @@ func isConfigVersionMissingErr(err error) bool {
 	return strings.Contains(strings.ToLower(err.Error()), "configuration version is missing")
 }
 
-func createRun(tfeClient *tfe.Client, waitForRun bool, manualConfirm bool, isDestroyRun bool, ws *tfe.Workspace, message string) (*tfe.Run, error) {
+func createRun(ctx context.Context, tfeClient *tfe.Client, waitForRun bool, manualConfirm bool, isDestroyRun bool, ws *tfe.Workspace, runArgs map[string]interface{}) (*tfe.Run, error) {
 	// In fire-and-forget mode (waitForRun=false), autoapply is set to !manualConfirm
 	// This should be intuitive, as "manual confirm" is the opposite of "auto apply"
 	//
@@ -189,9 +213,31 @@ func createRun(tfeClient *tfe.Client, waitForRun bool, manualConfirm bool, isDes
 		AutoApply: tfe.Bool(autoApply),
 	}
 
-	if message != "" {
+	if message, _ := runArgs["message"].(string); message != "" {
 		runConfig.Message = tfe.String(message)
 	}
+	if targetAddrs, ok := runArgs["target_addrs"].([]interface{}); ok {
+		for _, addr := range targetAddrs {
+			runConfig.TargetAddrs = append(runConfig.TargetAddrs, addr.(string))
+		}
+	}
+	if replaceAddrs, ok := runArgs["replace_addrs"].([]interface{}); ok {
+		for _, addr := range replaceAddrs {
+			runConfig.ReplaceAddrs = append(runConfig.ReplaceAddrs, addr.(string))
+		}
+	}
+	if refreshOnly, _ := runArgs["refresh_only"].(bool); refreshOnly {
+		runConfig.RefreshOnly = tfe.Bool(true)
+	}
+	if allowEmptyApply, _ := runArgs["allow_empty_apply"].(bool); allowEmptyApply {
+		runConfig.AllowEmptyApply = tfe.Bool(true)
+	}
+	if variables, ok := runArgs["variables"].(map[string]interface{}); ok {
+		// Sort the variables so that the request is the same for every run.
+		for _, key := range slices.Sorted(maps.Keys(variables)) {
+			runConfig.Variables = append(runConfig.Variables, &tfe.RunVariable{Key: key, Value: variables[key].(string)})
+		}
+	}
 
 	log.Printf("[DEBUG] Create run for workspace: %s", ws.ID)
 	run, err := tfeClient.Runs.Create(ctx, runConfig)
@@ -211,20 +257,20 @@ func createRun(tfeClient *tfe.Client, waitForRun bool, manualConfirm bool, isDes
 	return run, nil
 }
 
-func confirmRun(tfeClient *tfe.Client, manualConfirm bool, isPlanOp bool, run *tfe.Run, ws *tfe.Workspace) error {
+func confirmRun(ctx context.Context, tfeClient *tfe.Client, manualConfirm bool, isPlanOp bool, run *tfe.Run, ws *tfe.Workspace) error {
 	// if human approval is required, an apply will auto kick off when run is manually approved
 	if manualConfirm {
 		confirmationPendingStatus := map[tfe.RunStatus]bool{}
 		confirmationPendingStatus[run.Status] = true
 
 		log.Printf("[INFO] Plan complete, waiting for manual confirm before proceeding run %q", run.ID)
-		_, err := awaitRun(tfeClient, run.ID, ws.Organization.Name, isPlanOp, confirmationPendingStatus, isConfirmed)
+		_, err := awaitRun(ctx, tfeClient, run, ws.Organization.Name, isPlanOp, confirmationPendingStatus, isConfirmed)
 		if err != nil {
 			return err
 		}
 	} else {
 		// if human approval is NOT required, go ahead and kick off an apply
-		err := applyRun(tfeClient, run)
+		err := applyRun(ctx, tfeClient, run)
 		if err != nil {
 			return err
 		}
@@ -232,7 +278,7 @@ func confirmRun(tfeClient *tfe.Client, manualConfirm bool, isPlanOp bool, run *t
 	return nil
 }
 
-func applyRun(tfeClient *tfe.Client, run *tfe.Run) error {
+func applyRun(ctx context.Context, tfeClient *tfe.Client, run *tfe.Run) error {
 	log.Printf("[INFO] Plan complete, confirming an apply for run %q", run.ID)
 	err := tfeClient.Runs.Apply(ctx, run.ID, tfe.RunApplyOptions{
 		Comment: tfe.String(fmt.Sprintf("Run confirmed by tfe_workspace_run resource via terraform-provider-tfe on %s",
@@ -249,30 +295,61 @@ func applyRun(tfeClient *tfe.Client, run *tfe.Run) error {
 	return nil
 }
 
-func completeOrRetryRun(meta interface{}, run *tfe.Run, d *schema.ResourceData, retry bool, currentRetryAttempts int, retryMaxAttempts int, isDestroyRun bool) error {
+func completeOrRetryRun(ctx context.Context, meta interface{}, run *tfe.Run, d *schema.ResourceData, retry bool, currentRetryAttempts int, retryMaxAttempts int, isDestroyRun bool) error {
 	switch run.Status {
 	case tfe.RunApplied:
 		log.Printf("[INFO] Apply complete for run %q", run.ID)
+		if isDestroyRun {
+			// The resource is removed from state after its destroy run, so the
+			// result of the run can only be logged.
+			logRunResult(ctx, meta.(ConfiguredClient).Client, run.ID)
+		}
 		d.SetId(run.ID)
 		return nil
 	case tfe.RunErrored:
 		if retry && currentRetryAttempts < retryMaxAttempts {
 			currentRetryAttempts++
 			log.Printf("[INFO] Run errored during apply, retrying run, retry count: %d", currentRetryAttempts)
-			return createWorkspaceRun(d, meta, isDestroyRun, currentRetryAttempts)
+			return createWorkspaceRun(ctx, d, meta, isDestroyRun, currentRetryAttempts)
 		}
-		return fmt.Errorf("run errored during apply, use the run ID %s to debug error", run.ID)
+		return newRunErroredError(ctx, meta.(ConfiguredClient).Client, run, false)
 	default:
 		// unexpected run states including canceled and discarded is handled by this block
 		return fmt.Errorf("run %s entered unexpected state %s, expected %s state", run.ID, run.Status, tfe.RunApplied)
 	}
 }
 
-func awaitRun(tfeClient *tfe.Client, runID string, organization string, isPlanOp bool, runPendingStatus map[tfe.RunStatus]bool, isDone func(*tfe.Run) bool) (*tfe.Run, error) {
+// runProgressFunc receives the progress of a run while it is awaited, such as
+// its status transitions and its position in the queue.
+type runProgressFunc func(message string)
+
+// awaitRun polls the run until isDone. When the context is cancelled, for
+// example because Terraform was interrupted, it stops polling right away and
+// leaves the run as is.
+func awaitRun(ctx context.Context, tfeClient *tfe.Client, run *tfe.Run, organization string, isPlanOp bool, runPendingStatus map[tfe.RunStatus]bool, isDone func(*tfe.Run) bool) (*tfe.Run, error) {
+	return awaitRunWithProgress(ctx, tfeClient, run, organization, isPlanOp, runPendingStatus, isDone, nil)
+}
+
+// awaitRunWithProgress is awaitRun, also sending every new status and
+// progress message of the run to progress, if not nil.
+func awaitRunWithProgress(ctx context.Context, tfeClient *tfe.Client, run *tfe.Run, organization string, isPlanOp bool, runPendingStatus map[tfe.RunStatus]bool, isDone func(*tfe.Run) bool, progress runProgressFunc) (*tfe.Run, error) {
+	lastMessage := ""
+	report := func(message string) {
+		if progress != nil && message != "" && message != lastMessage {
+			progress(message)
+			lastMessage = message
+		}
+	}
+
+	runID, status := run.ID, run.Status
 	for i := 0; ; i++ {
 		select {
 		case <-ctx.Done():
-			return nil, fmt.Errorf("context canceled: %w", ctx.Err())
+			log.Printf("[INFO] Stopped waiting for run %s, status is %s: %v", runID, status, ctx.Err())
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return nil, fmt.Errorf("timed out waiting for run %s, run left in state %s: %w", runID, status, ctx.Err())
+			}
+			return nil, fmt.Errorf("operation cancelled, run %s left in state %s: %w", runID, status, ctx.Err())
 		case <-time.After(backoff(backoffMin, backoffMax, i)):
 			log.Printf("[DEBUG] Polling run %s", runID)
 			run, err := tfeClient.Runs.Read(ctx, runID)
@@ -280,8 +357,12 @@ func awaitRun(tfeClient *tfe.Client, runID string, organization string, isPlanOp
 				log.Printf("[ERROR] Could not read run %s: %v", runID, err)
 				continue
 			}
+			if run.Status != status {
+				report(fmt.Sprintf("Run %s status: %s", runID, run.Status))
+			}
+			status = run.Status
 
-			run, err = hasFinalStatus(tfeClient, run, organization, isPlanOp, runPendingStatus, isDone)
+			run, err = hasFinalStatus(ctx, tfeClient, run, organization, isPlanOp, runPendingStatus, isDone, report)
 			if run == nil && err == nil {
 				// if both error and run is nil, then run is still in progress
 				continue
@@ -292,7 +373,7 @@ func awaitRun(tfeClient *tfe.Client, runID string, organization string, isPlanOp
 	}
 }
 
-func hasFinalStatus(tfeClient *tfe.Client, run *tfe.Run, organization string, isPlanOp bool, runPendingStatus map[tfe.RunStatus]bool, isDone func(*tfe.Run) bool) (*tfe.Run, error) {
+func hasFinalStatus(ctx context.Context, tfeClient *tfe.Client, run *tfe.Run, organization string, isPlanOp bool, runPendingStatus map[tfe.RunStatus]bool, isDone func(*tfe.Run) bool, progress runProgressFunc) (*tfe.Run, error) {
 	_, runIsInProgress := runPendingStatus[run.Status]
 
 	switch {
@@ -300,7 +381,7 @@ func hasFinalStatus(tfeClient *tfe.Client, run *tfe.Run, organization string, is
 		log.Printf("[INFO] Run %s has reached a terminal state: %s", run.ID, run.Status)
 		return run, nil
 	case runIsInProgress:
-		logRunProgress(tfeClient, organization, isPlanOp, run)
+		progress(logRunProgress(ctx, tfeClient, organization, isPlanOp, run))
 		return nil, nil
 	case run.Status == tfe.RunCanceled:
 		log.Printf("[INFO] Run %s has been canceled, status is %s", run.ID, run.Status)
@@ -311,12 +392,15 @@ func hasFinalStatus(tfeClient *tfe.Client, run *tfe.Run, organization string, is
 	}
 }
 
-func logRunProgress(tfeClient *tfe.Client, organization string, isPlanOp bool, run *tfe.Run) {
+// logRunProgress logs why the run is still in progress, such as its position
+// in the queue, and returns the logged message. It returns an empty string
+// when the progress cannot be read.
+func logRunProgress(ctx context.Context, tfeClient *tfe.Client, organization string, isPlanOp bool, run *tfe.Run) string {
 	log.Printf("[DEBUG] Reading workspace %s", run.Workspace.ID)
 	ws, err := tfeClient.Workspaces.ReadByID(ctx, run.Workspace.ID)
 	if err != nil {
 		log.Printf("[ERROR] Unable to read workspace %s: %v", run.Workspace.ID, err)
-		return
+		return ""
 	}
 
 	// if the workspace is locked and the current run has not started, assume that workspace was locked for other purposes.
@@ -325,54 +409,56 @@ func logRunProgress(tfeClient *tfe.Client, organization string, isPlanOp bool, r
 		currentRun, err := tfeClient.Runs.Read(ctx, ws.CurrentRun.ID)
 		if err != nil {
 			log.Printf("[ERROR] Unable to read current run %s: %v", ws.CurrentRun.ID, err)
-			return
+			return ""
 		}
 
 		if currentRun.Status == tfe.RunPending {
-			log.Printf("[INFO] Waiting for manually locked workspace to be unlocked")
-			return
+			return logRunProgressMessage("Waiting for manually locked workspace to be unlocked")
 		}
 	}
 
 	// if this run is the current run in it's workspace, display it's position in the organization queue
 	if ws.CurrentRun != nil && ws.CurrentRun.ID == run.ID {
-		runPositionInOrg, err := readRunPositionInOrgQueue(tfeClient, run.ID, organization)
+		runPositionInOrg, err := readRunPositionInOrgQueue(ctx, tfeClient, run.ID, organization)
 		if err != nil {
 			log.Printf("[ERROR] Unable to read run position in organization queue %v", err)
-			return
+			return ""
 		}
 
 		orgCapacity, err := tfeClient.Organizations.ReadCapacity(ctx, organization)
 		if err != nil {
 			log.Printf("[ERROR] Unable to read capacity for organization %s: %v", organization, err)
-			return
+			return ""
 		}
 		if runPositionInOrg > 0 {
-			log.Printf("[INFO] Waiting for %d queued run(s) before starting run", runPositionInOrg-orgCapacity.Running)
-			return
+			return logRunProgressMessage(fmt.Sprintf("Waiting for %d queued run(s) before starting run", runPositionInOrg-orgCapacity.Running))
 		}
 	}
 
 	// if this run is not the current run in it's workspace, display it's position in the workspace queue
-	runPositionInWorkspace, err := readRunPositionInWorkspaceQueue(tfeClient, run.ID, ws.ID, isPlanOp, ws.CurrentRun)
+	runPositionInWorkspace, err := readRunPositionInWorkspaceQueue(ctx, tfeClient, run.ID, ws.ID, isPlanOp, ws.CurrentRun)
 	if err != nil {
 		log.Printf("[ERROR] Unable to read run position in workspace queue %v", err)
-		return
+		return ""
 	}
 
 	if runPositionInWorkspace > 0 {
-		log.Printf(
-			"[INFO] Waiting for %d run(s) to finish in workspace %s before being queued...",
+		return logRunProgressMessage(fmt.Sprintf(
+			"Waiting for %d run(s) to finish in workspace %s before being queued...",
 			runPositionInWorkspace,
 			ws.Name,
-		)
-		return
+		))
 	}
 
-	log.Printf("[INFO] Waiting for run %s, status is %s", run.ID, run.Status)
+	return logRunProgressMessage(fmt.Sprintf("Waiting for run %s, status is %s", run.ID, run.Status))
 }
 
-func readRunPositionInOrgQueue(tfeClient *tfe.Client, runID string, organization string) (int, error) {
+func logRunProgressMessage(message string) string {
+	log.Printf("[INFO] %s", message)
+	return message
+}
+
+func readRunPositionInOrgQueue(ctx context.Context, tfeClient *tfe.Client, runID string, organization string) (int, error) {
 	position := 0
 	options := tfe.ReadRunQueueOptions{}
 
@@ -399,7 +485,7 @@ func readRunPositionInOrgQueue(tfeClient *tfe.Client, runID string, organization
 	return position, nil
 }
 
-func readRunPositionInWorkspaceQueue(tfeClient *tfe.Client, runID string, wsID string, isPlanOp bool, currentRun *tfe.Run) (int, error) {
+func readRunPositionInWorkspaceQueue(ctx context.Context, tfeClient *tfe.Client, runID string, wsID string, isPlanOp bool, currentRun *tfe.Run) (int, error) {
 	position := 0
 	options := tfe.RunListOptions{}
 	found := false
@@ -457,7 +543,7 @@ func backoff(minVal float64, maxVal float64, iter int) time.Duration {
 	return time.Duration(backoff) * time.Millisecond
 }
 
-func readPostPlanTaskStageInRun(tfeClient *tfe.Client, runID string) (bool, error) {
+func readPostPlanTaskStageInRun(ctx context.Context, tfeClient *tfe.Client, runID string) (bool, error) {
 	hasPostPlanTaskStage := false
 	options := tfe.TaskStageListOptions{}
 
@@ -551,6 +637,14 @@ func isManuallyOverriden(run *tfe.Run) bool {
 	return found
 }
 
+// isPostPlanOverridden reports whether the run moved on after its post-plan
+// stage was overridden, either to a status past the plan or to a status in
+// which it awaits confirmation.
+func isPostPlanOverridden(run *tfe.Run) bool {
+	_, found := postPlanOverriddenStatuses[run.Status]
+	return found || (run.Status != tfe.RunPostPlanAwaitingDecision && run.Actions != nil && run.Actions.IsConfirmable)
+}
+
 func isPlannedAndFinished(run *tfe.Run) bool {
 	return tfe.RunPlannedAndFinished == run.Status
 }
@@ -568,3 +662,189 @@ func isCompleted(run *tfe.Run) bool {
 	_, found := applyDoneStatuses[run.Status]
 	return found
 }
+
+// getPolicyOverrideComment returns the justification of the policy_override
+// block of the run arguments, if the block is set.
+func getPolicyOverrideComment(runArgs map[string]interface{}) (string, bool) {
+	policyOverride, ok := runArgs["policy_override"].([]interface{})
+	if !ok || len(policyOverride) == 0 || policyOverride[0] == nil {
+		return "", false
+	}
+	return policyOverride[0].(map[string]interface{})["comment"].(string), true
+}
+
+// overrideRunPolicies overrides the soft-failed Sentinel policy checks and the
+// task stages awaiting an override, such as failed OPA policy evaluations and
+// failed run tasks, of the run. It fails before overriding anything when the
+// token cannot override all of them, and records the justification in a run
+// comment once all of them are overridden.
+func overrideRunPolicies(ctx context.Context, tfeClient *tfe.Client, run *tfe.Run, comment string) error {
+	policyChecks, err := tfeClient.PolicyChecks.List(ctx, run.ID, nil)
+	if err != nil {
+		return fmt.Errorf("error reading policy checks of run %s: %w", run.ID, err)
+	}
+	taskStages, err := tfeClient.TaskStages.List(ctx, run.ID, nil)
+	if err != nil {
+		return fmt.Errorf("error reading task stages of run %s: %w", run.ID, err)
+	}
+
+	var overridablePolicyChecks []*tfe.PolicyCheck
+	for _, policyCheck := range policyChecks.Items {
+		if policyCheck.Status != tfe.PolicySoftFailed {
+			continue
+		}
+		if policyCheck.Permissions == nil || !policyCheck.Permissions.CanOverride {
+			return fmt.Errorf("the token cannot override policy check %s of run %s, policy_override requires a token with permission to override policies", policyCheck.ID, run.ID)
+		}
+		overridablePolicyChecks = append(overridablePolicyChecks, policyCheck)
+	}
+
+	var overridableTaskStages []*tfe.TaskStage
+	for _, taskStage := range taskStages.Items {
+		if taskStage.Status != tfe.TaskStageAwaitingOverride {
+			continue
+		}
+		if !canOverrideTaskStage(taskStage) {
+			return fmt.Errorf("the token cannot override task stage %s of run %s, policy_override requires a token with permission to override policies and run tasks", taskStage.ID, run.ID)
+		}
+		overridableTaskStages = append(overridableTaskStages, taskStage)
+	}
+
+	overrider := "the tfe_workspace_run resource"
+	if user, err := tfeClient.Users.ReadCurrent(ctx); err != nil {
+		log.Printf("[WARN] Unable to read the user of the token overriding run %s: %v", run.ID, err)
+	} else {
+		overrider = user.Username
+	}
+
+	log.Printf("[INFO] Overriding %d policy check(s) and %d task stage(s) of run %s", len(overridablePolicyChecks), len(overridableTaskStages), run.ID)
+	for _, policyCheck := range overridablePolicyChecks {
+		if _, err := tfeClient.PolicyChecks.Override(ctx, policyCheck.ID); err != nil {
+			return fmt.Errorf("error overriding policy check %s of run %s: %w", policyCheck.ID, run.ID, err)
+		}
+	}
+	for _, taskStage := range overridableTaskStages {
+		_, err := tfeClient.TaskStages.Override(ctx, taskStage.ID, tfe.TaskStageOverrideOptions{Comment: tfe.String(comment)})
+		if err != nil {
+			return fmt.Errorf("error overriding task stage %s of run %s: %w", taskStage.ID, run.ID, err)
+		}
+	}
+
+	// The justification is only recorded once everything was overridden, so
+	// that the comment never claims a failed override.
+	_, err = tfeClient.Comments.Create(ctx, run.ID, tfe.CommentCreateOptions{
+		Body: fmt.Sprintf("Policies overridden by %s via terraform-provider-tfe: %s", overrider, comment),
+	})
+	if err != nil {
+		return fmt.Errorf("error commenting the justification of the policy override on run %s: %w", run.ID, err)
+	}
+
+	return nil
+}
+
+// canOverrideTaskStage reports whether the token can override the policy
+// evaluations and run task results of the task stage.
+func canOverrideTaskStage(taskStage *tfe.TaskStage) bool {
+	permissions := taskStage.Permissions
+	if permissions == nil {
+		return false
+	}
+	if permissions.CanOverride != nil && !*permissions.CanOverride {
+		return false
+	}
+	if len(taskStage.PolicyEvaluations) > 0 && (permissions.CanOverridePolicy == nil || !*permissions.CanOverridePolicy) {
+		return false
+	}
+	if len(taskStage.TaskResults) > 0 && (permissions.CanOverrideTasks == nil || !*permissions.CanOverrideTasks) {
+		return false
+	}
+	return true
+}
+
+// runResult is the outcome of a run: the resources it applied, or planned
+// when it was not applied, and its cost estimate.
+type runResult struct {
+	additions        int
+	changes          int
+	destructions     int
+	imports          int
+	deltaMonthlyCost string
+}
+
+// readRunWithResult reads the run including the relations newRunResult and
+// runHTMLURL use.
+func readRunWithResult(ctx context.Context, tfeClient *tfe.Client, runID string) (*tfe.Run, error) {
+	return tfeClient.Runs.ReadWithOptions(ctx, runID, &tfe.RunReadOptions{
+		Include: []tfe.RunIncludeOpt{tfe.RunPlan, tfe.RunApply, tfe.RunCostEstimate, tfe.RunWorkspace},
+	})
+}
+
+func newRunResult(run *tfe.Run) runResult {
+	var result runResult
+
+	switch {
+	case run.Status == tfe.RunApplied && run.Apply != nil:
+		result.additions = run.Apply.ResourceAdditions
+		result.changes = run.Apply.ResourceChanges
+		result.destructions = run.Apply.ResourceDestructions
+		result.imports = run.Apply.ResourceImports
+	case run.Plan != nil:
+		result.additions = run.Plan.ResourceAdditions
+		result.changes = run.Plan.ResourceChanges
+		result.destructions = run.Plan.ResourceDestructions
+		result.imports = run.Plan.ResourceImports
+	}
+
+	if run.CostEstimate != nil {
+		result.deltaMonthlyCost = run.CostEstimate.DeltaMonthlyCost
+	}
+
+	return result
+}
+
+// readRunPolicyCheckStatus returns the status of the last policy check of the
+// run, or an empty string when no policy check ran.
+func readRunPolicyCheckStatus(ctx context.Context, tfeClient *tfe.Client, run *tfe.Run) (string, error) {
+	if len(run.PolicyChecks) == 0 {
+		return "", nil
+	}
+
+	policyChecks, err := tfeClient.PolicyChecks.List(ctx, run.ID, nil)
+	if err != nil {
+		return "", fmt.Errorf("error reading policy checks of run %s: %w", run.ID, err)
+	}
+	if len(policyChecks.Items) == 0 {
+		return "", nil
+	}
+
+	return string(policyChecks.Items[len(policyChecks.Items)-1].Status), nil
+}
+
+// runHTMLURL returns the URL of the run in the UI, or an empty string when the
+// run was read without its workspace.
+func runHTMLURL(tfeClient *tfe.Client, run *tfe.Run) string {
+	if run.Workspace == nil || run.Workspace.Name == "" || run.Workspace.Organization == nil {
+		return ""
+	}
+
+	baseAPI := tfeClient.BaseURL()
+	htmlURL := url.URL{
+		Scheme: baseAPI.Scheme,
+		Host:   baseAPI.Host,
+		Path:   fmt.Sprintf("/app/%s/workspaces/%s/runs/%s", run.Workspace.Organization.Name, run.Workspace.Name, run.ID),
+	}
+
+	return htmlURL.String()
+}
+
+func logRunResult(ctx context.Context, tfeClient *tfe.Client, runID string) {
+	run, err := readRunWithResult(ctx, tfeClient, runID)
+	if err != nil {
+		log.Printf("[WARN] Unable to read the result of run %s: %v", runID, err)
+		return
+	}
+
+	result := newRunResult(run)
+	log.Printf("[INFO] Run %s %s: %d added, %d changed, %d destroyed, %d imported",
+		run.ID, run.Status, result.additions, result.changes, result.destructions, result.imports)
+}
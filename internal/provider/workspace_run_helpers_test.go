@@ -4,7 +4,10 @@
 package provider
 
 import (
+	"context"
 	"errors"
+	"reflect"
+	"strings"
 	"testing"
 
 	tfe "github.com/hashicorp/go-tfe"
@@ -45,7 +48,7 @@ func TestCreateWorkspaceRun_destroyNoOpsOnMissingConfigVersion(t *testing.T) {
 
 	meta := ConfiguredClient{Client: client, Organization: "hashicorp"}
 
-	err := createWorkspaceRun(d, meta, true, 0)
+	err := createWorkspaceRun(ctx, d, meta, true, 0)
 	if err != nil {
 		t.Fatalf("expected destroy to no-op on missing config version, got error: %v", err)
 	}
@@ -86,6 +89,416 @@ func TestIsConfigVersionMissingErr(t *testing.T) {
 	}
 }
 
+func TestCreateRun_options(t *testing.T) {
+	client := testTfeClient(t, testClientOptions{})
+
+	var options tfe.RunCreateOptions
+	ctrl := gomock.NewController(t)
+	mockRunsAPI := tfemocks.NewMockRuns(ctrl)
+	mockRunsAPI.
+		EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, o tfe.RunCreateOptions) (*tfe.Run, error) {
+			options = o
+			return &tfe.Run{ID: "run-01"}, nil
+		}).
+		Times(1)
+	client.Runs = mockRunsAPI
+
+	runArgs := map[string]interface{}{
+		"message":           "",
+		"target_addrs":      []interface{}{"random_pet.a"},
+		"replace_addrs":     []interface{}{"random_pet.b"},
+		"refresh_only":      false,
+		"allow_empty_apply": true,
+		"variables": map[string]interface{}{
+			"region": `"us-east-1"`,
+			"count":  "2",
+		},
+	}
+	if _, err := createRun(ctx, client, true, false, false, &tfe.Workspace{ID: "ws-01"}, runArgs); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if options.Message != nil || options.RefreshOnly != nil {
+		t.Fatalf("expected unset options to be omitted, got message %v and refresh_only %v", options.Message, options.RefreshOnly)
+	}
+	if !reflect.DeepEqual(options.TargetAddrs, []string{"random_pet.a"}) || !reflect.DeepEqual(options.ReplaceAddrs, []string{"random_pet.b"}) {
+		t.Fatalf("unexpected addresses: targets %v, replacements %v", options.TargetAddrs, options.ReplaceAddrs)
+	}
+	if options.AllowEmptyApply == nil || !*options.AllowEmptyApply {
+		t.Fatal("expected allow_empty_apply to be set")
+	}
+	expectedVariables := []*tfe.RunVariable{{Key: "count", Value: "2"}, {Key: "region", Value: `"us-east-1"`}}
+	if !reflect.DeepEqual(options.Variables, expectedVariables) {
+		t.Fatalf("expected variables sorted by key, got %v", options.Variables)
+	}
+}
+
+func TestOverrideRunPolicies(t *testing.T) {
+	run := &tfe.Run{ID: "run-01", Status: tfe.RunPolicyOverride}
+
+	t.Run("overrides and comments", func(t *testing.T) {
+		client := testTfeClient(t, testClientOptions{})
+		ctrl := gomock.NewController(t)
+
+		mockPolicyChecksAPI := tfemocks.NewMockPolicyChecks(ctrl)
+		mockPolicyChecksAPI.EXPECT().List(gomock.Any(), "run-01", gomock.Any()).Return(&tfe.PolicyCheckList{Items: []*tfe.PolicyCheck{
+			{ID: "polchk-passed", Status: tfe.PolicyPasses},
+			{ID: "polchk-soft", Status: tfe.PolicySoftFailed, Permissions: &tfe.PolicyPermissions{CanOverride: true}},
+		}}, nil)
+		mockPolicyChecksAPI.EXPECT().Override(gomock.Any(), "polchk-soft").Return(&tfe.PolicyCheck{ID: "polchk-soft"}, nil).Times(1)
+		client.PolicyChecks = mockPolicyChecksAPI
+
+		mockTaskStagesAPI := tfemocks.NewMockTaskStages(ctrl)
+		mockTaskStagesAPI.EXPECT().List(gomock.Any(), "run-01", gomock.Any()).Return(&tfe.TaskStageList{Items: []*tfe.TaskStage{
+			{
+				ID:                "ts-opa",
+				Status:            tfe.TaskStageAwaitingOverride,
+				Permissions:       &tfe.Permissions{CanOverridePolicy: tfe.Bool(true)},
+				PolicyEvaluations: []*tfe.PolicyEvaluation{{ID: "poleval-01"}},
+			},
+		}}, nil)
+		mockTaskStagesAPI.EXPECT().Override(gomock.Any(), "ts-opa", tfe.TaskStageOverrideOptions{Comment: tfe.String("incident 42")}).Return(&tfe.TaskStage{ID: "ts-opa"}, nil).Times(1)
+		client.TaskStages = mockTaskStagesAPI
+
+		mockUsersAPI := tfemocks.NewMockUsers(ctrl)
+		mockUsersAPI.EXPECT().ReadCurrent(gomock.Any()).Return(&tfe.User{Username: "breakglass"}, nil)
+		client.Users = mockUsersAPI
+
+		mockCommentsAPI := tfemocks.NewMockComments(ctrl)
+		mockCommentsAPI.EXPECT().Create(gomock.Any(), "run-01", tfe.CommentCreateOptions{
+			Body: "Policies overridden by breakglass via terraform-provider-tfe: incident 42",
+		}).Return(&tfe.Comment{}, nil).Times(1)
+		client.Comments = mockCommentsAPI
+
+		if err := overrideRunPolicies(ctx, client, run, "incident 42"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("does not comment when an override fails", func(t *testing.T) {
+		client := testTfeClient(t, testClientOptions{})
+		ctrl := gomock.NewController(t)
+
+		mockPolicyChecksAPI := tfemocks.NewMockPolicyChecks(ctrl)
+		mockPolicyChecksAPI.EXPECT().List(gomock.Any(), "run-01", gomock.Any()).Return(&tfe.PolicyCheckList{Items: []*tfe.PolicyCheck{
+			{ID: "polchk-soft", Status: tfe.PolicySoftFailed, Permissions: &tfe.PolicyPermissions{CanOverride: true}},
+		}}, nil)
+		mockPolicyChecksAPI.EXPECT().Override(gomock.Any(), "polchk-soft").Return(nil, errors.New("conflict"))
+		client.PolicyChecks = mockPolicyChecksAPI
+
+		mockTaskStagesAPI := tfemocks.NewMockTaskStages(ctrl)
+		mockTaskStagesAPI.EXPECT().List(gomock.Any(), "run-01", gomock.Any()).Return(&tfe.TaskStageList{}, nil)
+		client.TaskStages = mockTaskStagesAPI
+
+		mockUsersAPI := tfemocks.NewMockUsers(ctrl)
+		mockUsersAPI.EXPECT().ReadCurrent(gomock.Any()).Return(&tfe.User{Username: "breakglass"}, nil)
+		client.Users = mockUsersAPI
+
+		// No comment is expected.
+		client.Comments = tfemocks.NewMockComments(ctrl)
+
+		err := overrideRunPolicies(ctx, client, run, "incident 42")
+		if err == nil || !strings.Contains(err.Error(), "error overriding policy check polchk-soft") {
+			t.Fatalf("expected an override error, got %v", err)
+		}
+	})
+
+	t.Run("fails without permission", func(t *testing.T) {
+		client := testTfeClient(t, testClientOptions{})
+		ctrl := gomock.NewController(t)
+
+		mockPolicyChecksAPI := tfemocks.NewMockPolicyChecks(ctrl)
+		mockPolicyChecksAPI.EXPECT().List(gomock.Any(), "run-01", gomock.Any()).Return(&tfe.PolicyCheckList{Items: []*tfe.PolicyCheck{
+			{ID: "polchk-soft", Status: tfe.PolicySoftFailed, Permissions: &tfe.PolicyPermissions{CanOverride: false}},
+		}}, nil)
+		client.PolicyChecks = mockPolicyChecksAPI
+
+		mockTaskStagesAPI := tfemocks.NewMockTaskStages(ctrl)
+		mockTaskStagesAPI.EXPECT().List(gomock.Any(), "run-01", gomock.Any()).Return(&tfe.TaskStageList{}, nil)
+		client.TaskStages = mockTaskStagesAPI
+
+		err := overrideRunPolicies(ctx, client, run, "incident 42")
+		if err == nil || !strings.Contains(err.Error(), "the token cannot override policy check polchk-soft") {
+			t.Fatalf("expected a permission error, got %v", err)
+		}
+	})
+}
+
+func TestNewRunResult(t *testing.T) {
+	plan := &tfe.Plan{ResourceAdditions: 3, ResourceChanges: 2, ResourceDestructions: 1}
+	apply := &tfe.Apply{ResourceAdditions: 3, ResourceChanges: 1, ResourceImports: 1}
+
+	testCases := map[string]struct {
+		run      *tfe.Run
+		expected runResult
+	}{
+		"applied run": {
+			&tfe.Run{Status: tfe.RunApplied, Plan: plan, Apply: apply, CostEstimate: &tfe.CostEstimate{DeltaMonthlyCost: "12.50"}},
+			runResult{additions: 3, changes: 1, imports: 1, deltaMonthlyCost: "12.50"},
+		},
+		"planned run": {
+			&tfe.Run{Status: tfe.RunPlannedAndFinished, Plan: plan, Apply: apply},
+			runResult{additions: 3, changes: 2, destructions: 1},
+		},
+		"run without relations": {
+			&tfe.Run{Status: tfe.RunApplied},
+			runResult{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := newRunResult(testCase.run); got != testCase.expected {
+				t.Fatalf("expected %+v, got %+v", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestReadRunPolicyCheckStatus(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("run without policy checks", func(t *testing.T) {
+		client := testTfeClient(t, testClientOptions{})
+		ctrl := gomock.NewController(t)
+		// No policy check is listed, as the run has none.
+		client.PolicyChecks = tfemocks.NewMockPolicyChecks(ctrl)
+
+		status, err := readRunPolicyCheckStatus(ctx, client, &tfe.Run{ID: "run-01"})
+		if err != nil || status != "" {
+			t.Fatalf("expected no status, got %q and %v", status, err)
+		}
+	})
+
+	t.Run("last policy check", func(t *testing.T) {
+		client := testTfeClient(t, testClientOptions{})
+		ctrl := gomock.NewController(t)
+		mockPolicyChecksAPI := tfemocks.NewMockPolicyChecks(ctrl)
+		mockPolicyChecksAPI.EXPECT().List(gomock.Any(), "run-01", gomock.Any()).Return(&tfe.PolicyCheckList{Items: []*tfe.PolicyCheck{
+			{ID: "polchk-01", Status: tfe.PolicySoftFailed},
+			{ID: "polchk-02", Status: tfe.PolicyOverridden},
+		}}, nil)
+		client.PolicyChecks = mockPolicyChecksAPI
+
+		run := &tfe.Run{ID: "run-01", PolicyChecks: []*tfe.PolicyCheck{{ID: "polchk-01"}, {ID: "polchk-02"}}}
+		status, err := readRunPolicyCheckStatus(ctx, client, run)
+		if err != nil || status != string(tfe.PolicyOverridden) {
+			t.Fatalf("expected status %s, got %q and %v", tfe.PolicyOverridden, status, err)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		client := testTfeClient(t, testClientOptions{})
+		ctrl := gomock.NewController(t)
+		mockPolicyChecksAPI := tfemocks.NewMockPolicyChecks(ctrl)
+		mockPolicyChecksAPI.EXPECT().List(gomock.Any(), "run-01", gomock.Any()).Return(nil, errors.New("forbidden"))
+		client.PolicyChecks = mockPolicyChecksAPI
+
+		run := &tfe.Run{ID: "run-01", PolicyChecks: []*tfe.PolicyCheck{{ID: "polchk-01"}}}
+		_, err := readRunPolicyCheckStatus(ctx, client, run)
+		if err == nil || err.Error() != "error reading policy checks of run run-01: forbidden" {
+			t.Fatalf("expected an error reading the policy checks, got %v", err)
+		}
+	})
+}
+
+func TestAwaitRun_stopsWhenCancelled(t *testing.T) {
+	client := testTfeClient(t, testClientOptions{})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	mockRunsAPI := tfemocks.NewMockRuns(ctrl)
+	mockRunsAPI.
+		EXPECT().
+		Read(gomock.Any(), "run-01").
+		DoAndReturn(func(context.Context, string) (*tfe.Run, error) {
+			// Terraform is interrupted while the run is planning.
+			cancel()
+			return &tfe.Run{
+				ID:        "run-01",
+				Status:    tfe.RunPlanning,
+				Workspace: &tfe.Workspace{ID: "ws-unknown"},
+			}, nil
+		}).
+		Times(1)
+	client.Runs = mockRunsAPI
+
+	run := &tfe.Run{ID: "run-01", Status: tfe.RunPending}
+	pendingStatuses, terminalStatuses := planStatuses(run, false)
+	_, err := awaitRun(ctx, client, run, "hashicorp", true, pendingStatuses, isPlanComplete(terminalStatuses))
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if expected := "operation cancelled, run run-01 left in state planning"; !strings.Contains(err.Error(), expected) {
+		t.Fatalf("expected error containing %q, got %q", expected, err)
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected error to wrap context.Canceled, got %v", err)
+	}
+}
+
+func TestAwaitRun_timesOut(t *testing.T) {
+	client := testTfeClient(t, testClientOptions{})
+	ctx, cancel := context.WithTimeout(context.Background(), 0)
+	defer cancel()
+
+	run := &tfe.Run{ID: "run-01", Status: tfe.RunPlanQueued}
+	pendingStatuses, terminalStatuses := planStatuses(run, false)
+	_, err := awaitRun(ctx, client, run, "hashicorp", true, pendingStatuses, isPlanComplete(terminalStatuses))
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if expected := "timed out waiting for run run-01, run left in state plan_queued"; !strings.Contains(err.Error(), expected) {
+		t.Fatalf("expected error containing %q, got %q", expected, err)
+	}
+	if !isTimeoutError(err) {
+		t.Fatalf("expected a timeout error, got %v", err)
+	}
+}
+
+func TestAwaitRun_afterPostPlanOverride(t *testing.T) {
+	testFastBackoff(t)
+	client := testTfeClient(t, testClientOptions{})
+
+	ctrl := gomock.NewController(t)
+	mockRunsAPI := tfemocks.NewMockRuns(ctrl)
+	gomock.InOrder(
+		mockRunsAPI.EXPECT().Read(gomock.Any(), "run-01").Return(&tfe.Run{
+			ID:        "run-01",
+			Status:    tfe.RunCostEstimating,
+			Actions:   &tfe.RunActions{},
+			Workspace: &tfe.Workspace{ID: "ws-unknown"},
+		}, nil),
+		mockRunsAPI.EXPECT().Read(gomock.Any(), "run-01").Return(&tfe.Run{
+			ID:        "run-01",
+			Status:    tfe.RunPlanned,
+			Actions:   &tfe.RunActions{IsConfirmable: true},
+			Workspace: &tfe.Workspace{ID: "ws-unknown"},
+		}, nil),
+	)
+	client.Runs = mockRunsAPI
+
+	mockWorkspacesAPI := tfemocks.NewMockWorkspaces(ctrl)
+	mockWorkspacesAPI.EXPECT().ReadByID(gomock.Any(), "ws-unknown").Return(nil, tfe.ErrResourceNotFound).AnyTimes()
+	client.Workspaces = mockWorkspacesAPI
+
+	mockTaskStagesAPI := tfemocks.NewMockTaskStages(ctrl)
+	mockTaskStagesAPI.EXPECT().List(gomock.Any(), "run-01", gomock.Any()).Return(&tfe.TaskStageList{Items: []*tfe.TaskStage{
+		{
+			ID:          "ts-task",
+			Status:      tfe.TaskStageAwaitingOverride,
+			Permissions: &tfe.Permissions{CanOverrideTasks: tfe.Bool(true)},
+			TaskResults: []*tfe.TaskResult{{ID: "taskrs-01"}},
+		},
+	}}, nil)
+	mockTaskStagesAPI.EXPECT().Override(gomock.Any(), "ts-task", gomock.Any()).Return(&tfe.TaskStage{ID: "ts-task"}, nil)
+	client.TaskStages = mockTaskStagesAPI
+
+	mockPolicyChecksAPI := tfemocks.NewMockPolicyChecks(ctrl)
+	mockPolicyChecksAPI.EXPECT().List(gomock.Any(), "run-01", gomock.Any()).Return(&tfe.PolicyCheckList{}, nil)
+	client.PolicyChecks = mockPolicyChecksAPI
+
+	mockUsersAPI := tfemocks.NewMockUsers(ctrl)
+	mockUsersAPI.EXPECT().ReadCurrent(gomock.Any()).Return(&tfe.User{Username: "breakglass"}, nil)
+	client.Users = mockUsersAPI
+
+	mockCommentsAPI := tfemocks.NewMockComments(ctrl)
+	mockCommentsAPI.EXPECT().Create(gomock.Any(), "run-01", gomock.Any()).Return(&tfe.Comment{}, nil)
+	client.Comments = mockCommentsAPI
+
+	run := &tfe.Run{ID: "run-01", Status: tfe.RunPostPlanAwaitingDecision}
+	if err := overrideRunPolicies(ctx, client, run, "incident 42"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	run, err := awaitRun(context.Background(), client, run, "hashicorp", true, postPlanOverridePendingStatuses, isPostPlanOverridden)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if run.Status != tfe.RunPlanned {
+		t.Fatalf("expected the run to be planned, got %s", run.Status)
+	}
+}
+
+func TestAwaitRun_postPlanAwaitingDecisionWithoutOverride(t *testing.T) {
+	testFastBackoff(t)
+	client := testTfeClient(t, testClientOptions{})
+
+	ctrl := gomock.NewController(t)
+	mockRunsAPI := tfemocks.NewMockRuns(ctrl)
+	mockRunsAPI.EXPECT().Read(gomock.Any(), "run-01").Return(&tfe.Run{
+		ID:        "run-01",
+		Status:    tfe.RunPostPlanAwaitingDecision,
+		Workspace: &tfe.Workspace{ID: "ws-unknown"},
+	}, nil)
+	client.Runs = mockRunsAPI
+
+	run := &tfe.Run{ID: "run-01", Status: tfe.RunPending}
+	pendingStatuses, terminalStatuses := planStatuses(run, true)
+	_, err := awaitRun(context.Background(), client, run, "hashicorp", true, pendingStatuses, isPlanComplete(terminalStatuses))
+	if err == nil || !strings.Contains(err.Error(), "unexpected state: post_plan_awaiting_decision") {
+		t.Fatalf("expected an unexpected state error, got %v", err)
+	}
+}
+
+// testFastBackoff shortens the polling backoff of awaitRun for the test.
+func testFastBackoff(t *testing.T) {
+	t.Helper()
+	minBackoff, maxBackoff := backoffMin, backoffMax
+	backoffMin, backoffMax = 1, 5
+	t.Cleanup(func() {
+		backoffMin, backoffMax = minBackoff, maxBackoff
+	})
+}
+
+func TestAwaitRunWithProgress_reportsStatuses(t *testing.T) {
+	client := testTfeClient(t, testClientOptions{})
+
+	ctrl := gomock.NewController(t)
+	mockRunsAPI := tfemocks.NewMockRuns(ctrl)
+	gomock.InOrder(
+		mockRunsAPI.EXPECT().Read(gomock.Any(), "run-01").Return(&tfe.Run{
+			ID:        "run-01",
+			Status:    tfe.RunPlanning,
+			Workspace: &tfe.Workspace{ID: "ws-unknown"},
+		}, nil),
+		mockRunsAPI.EXPECT().Read(gomock.Any(), "run-01").Return(&tfe.Run{
+			ID:        "run-01",
+			Status:    tfe.RunPlanned,
+			Workspace: &tfe.Workspace{ID: "ws-unknown"},
+		}, nil),
+	)
+	client.Runs = mockRunsAPI
+
+	// The queue position cannot be read, so only the statuses are reported.
+	mockWorkspacesAPI := tfemocks.NewMockWorkspaces(ctrl)
+	mockWorkspacesAPI.EXPECT().ReadByID(gomock.Any(), "ws-unknown").Return(nil, tfe.ErrResourceNotFound)
+	client.Workspaces = mockWorkspacesAPI
+
+	var messages []string
+	progress := func(message string) {
+		messages = append(messages, message)
+	}
+
+	run := &tfe.Run{ID: "run-01", Status: tfe.RunPending}
+	pendingStatuses, terminalStatuses := planStatuses(run, false)
+	run, err := awaitRunWithProgress(context.Background(), client, run, "hashicorp", true, pendingStatuses, isPlanComplete(terminalStatuses), progress)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if run.Status != tfe.RunPlanned {
+		t.Fatalf("expected run to be planned, got %s", run.Status)
+	}
+
+	expected := []string{"Run run-01 status: planning", "Run run-01 status: planned"}
+	if !reflect.DeepEqual(messages, expected) {
+		t.Fatalf("expected progress %q, got %q", expected, messages)
+	}
+}
+
 func MockRunsListForWorkspaceQueue(t *testing.T, client *tfe.Client, workspaceIDWithExpectedRun string, workspaceIDWithUnexpectedRun string) {
 	ctrl := gomock.NewController(t)
 	mockRunsAPI := tfemocks.NewMockRuns(ctrl)
@@ -188,6 +601,7 @@ func TestReadRunPositionInWorkspaceQueue(t *testing.T) {
 	for name, testCase := range testCases {
 		t.Run(name, func(t *testing.T) {
 			position, err := readRunPositionInWorkspaceQueue(
+				ctx,
 				client,
 				testCase.currentRunID,
 				testCase.workspace,
@@ -298,6 +712,7 @@ func TestReadRunPositionInOrgQueue(t *testing.T) {
 	for name, testCase := range testCases {
 		t.Run(name, func(t *testing.T) {
 			position, err := readRunPositionInOrgQueue(
+				ctx,
 				client,
 				"run-02",
 				testCase.orgName,